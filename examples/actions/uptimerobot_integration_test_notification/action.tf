@@ -0,0 +1,20 @@
+# Send a test notification whenever the webhook is created or changed.
+
+action "uptimerobot_integration_test_notification" "webhook" {
+  config {
+    integration_id = tonumber(uptimerobot_integration.webhook.id)
+  }
+}
+
+resource "uptimerobot_integration" "webhook" {
+  name  = "Deploy webhook"
+  type  = "webhook"
+  value = "https://hooks.example.com/uptimerobot"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.uptimerobot_integration_test_notification.webhook]
+    }
+  }
+}
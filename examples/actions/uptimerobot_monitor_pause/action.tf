@@ -0,0 +1,38 @@
+# Pause the monitor while traffic switches between the blue and green
+# deployments, and resume it afterwards. Leave is_paused unset on the monitor
+# so the actions do not show up as drift. Actions require Terraform 1.14 or
+# later.
+
+resource "uptimerobot_monitor" "website" {
+  name     = "Website"
+  type     = "HTTP"
+  url      = "https://example.com"
+  interval = 300
+}
+
+action "uptimerobot_monitor_pause" "website" {
+  config {
+    monitor_id = tonumber(uptimerobot_monitor.website.id)
+  }
+}
+
+action "uptimerobot_monitor_resume" "website" {
+  config {
+    monitor_id = tonumber(uptimerobot_monitor.website.id)
+  }
+}
+
+resource "terraform_data" "cutover" {
+  input = var.active_color
+
+  lifecycle {
+    action_trigger {
+      events  = [before_update]
+      actions = [action.uptimerobot_monitor_pause.website]
+    }
+    action_trigger {
+      events  = [after_update]
+      actions = [action.uptimerobot_monitor_resume.website]
+    }
+  }
+}
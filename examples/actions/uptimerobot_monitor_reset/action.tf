@@ -0,0 +1,23 @@
+# Clear the statistics of a monitor whenever it changes, or on demand with:
+#
+#   terraform apply -invoke=action.uptimerobot_monitor_reset.website
+
+action "uptimerobot_monitor_reset" "website" {
+  config {
+    monitor_id = tonumber(uptimerobot_monitor.website.id)
+  }
+}
+
+resource "uptimerobot_monitor" "website" {
+  name     = "Website"
+  type     = "HTTP"
+  url      = var.website_url
+  interval = 300
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.uptimerobot_monitor_reset.website]
+    }
+  }
+}
@@ -0,0 +1,9 @@
+# Resume a monitor on demand with:
+#
+#   terraform apply -invoke=action.uptimerobot_monitor_resume.website
+
+action "uptimerobot_monitor_resume" "website" {
+  config {
+    monitor_id = tonumber(uptimerobot_monitor.website.id)
+  }
+}
@@ -0,0 +1,28 @@
+# Fail the apply when the checkout monitors are not UP within ten minutes of
+# the deploy.
+data "uptimerobot_monitor_status" "checkout" {
+  tags     = ["checkout"]
+  wait_for = "UP"
+  timeout  = "10m"
+
+  depends_on = [terraform_data.deploy]
+
+  lifecycle {
+    postcondition {
+      condition     = self.ready
+      error_message = "Checkout monitors are not UP: ${join(", ", [for m in self.monitors : "${m.name} (${m.status})" if m.status != "UP"])}."
+    }
+  }
+}
+
+# Or only warn, on every plan and apply.
+check "api_up" {
+  data "uptimerobot_monitor_status" "api" {
+    monitor_ids = [tonumber(uptimerobot_monitor.api.id)]
+  }
+
+  assert {
+    condition     = data.uptimerobot_monitor_status.api.ready
+    error_message = "The API monitor is not UP."
+  }
+}
@@ -0,0 +1,18 @@
+provider "uptimerobot" {
+  api_key = var.uptimerobot_api_key
+
+  ownership_stamp {
+    key   = "managed_by"
+    value = "terraform:prod"
+  }
+}
+
+data "uptimerobot_unmanaged_objects" "audit" {
+  managed_psp_ids                = [for p in uptimerobot_psp.all : p.id]
+  managed_maintenance_window_ids = [for w in uptimerobot_maintenance_window.all : w.id]
+  managed_integration_ids        = [for i in uptimerobot_integration.all : i.id]
+}
+
+output "unmanaged_monitors" {
+  value = [for m in data.uptimerobot_unmanaged_objects.audit.monitors : "${m.id} ${m.name}"]
+}
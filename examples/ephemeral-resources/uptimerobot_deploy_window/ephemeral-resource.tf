@@ -0,0 +1,19 @@
+# Silence alerts for the API monitor and every monitor tagged "checkout" while
+# Terraform plans and applies. The window is deleted when the run ends; a run
+# that crashes leaves a window that ends 20 minutes after its last renewal.
+# Ephemeral resources require Terraform 1.10 or later.
+
+resource "uptimerobot_monitor" "api" {
+  name     = "API"
+  type     = "HTTP"
+  url      = "https://api.example.com/health"
+  interval = 300
+}
+
+ephemeral "uptimerobot_deploy_window" "release" {
+  name        = "Release"
+  monitor_ids = [tonumber(uptimerobot_monitor.api.id)]
+  tags        = ["checkout"]
+  duration    = "20m"
+  time_zone   = "Europe/Berlin"
+}
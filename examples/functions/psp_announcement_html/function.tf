@@ -0,0 +1,12 @@
+locals {
+  maintenance_notice = <<-EOT
+    We will upgrade the **API cluster**.
+
+    - Expect brief connection resets
+    - Follow [status updates](https://status.example.com)
+  EOT
+}
+
+output "maintenance_notice_html" {
+  value = provider::uptimerobot::psp_announcement_html(local.maintenance_notice)
+}
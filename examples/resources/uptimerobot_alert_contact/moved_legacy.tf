@@ -0,0 +1,18 @@
+# Previously, with louy/uptimerobot:
+#
+# resource "uptimerobot_alert_contact" "ops" {
+#   friendly_name = "Ops"
+#   type          = "email"
+#   value         = "ops@example.com"
+# }
+
+moved {
+  from = uptimerobot_alert_contact.ops
+  to   = uptimerobot_alert_contact.ops_email
+}
+
+resource "uptimerobot_alert_contact" "ops_email" {
+  name  = "Ops"
+  type  = "email"
+  value = "ops@example.com"
+}
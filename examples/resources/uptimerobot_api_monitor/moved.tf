@@ -0,0 +1,30 @@
+# Previously:
+#
+# resource "uptimerobot_monitor" "status" {
+#   type = "API"
+#   ...
+# }
+
+moved {
+  from = uptimerobot_monitor.status
+  to   = uptimerobot_api_monitor.status
+}
+
+resource "uptimerobot_api_monitor" "status" {
+  name     = "API status"
+  url      = "https://example.com/api/health"
+  interval = 300
+
+  config = {
+    api_assertions = {
+      logic = "AND"
+      checks = [
+        {
+          property   = "$.status"
+          comparison = "equals"
+          target     = jsonencode("ok")
+        },
+      ]
+    }
+  }
+}
@@ -0,0 +1,24 @@
+resource "uptimerobot_api_monitor" "status" {
+  name     = "API status"
+  url      = "https://example.com/api/health"
+  interval = 300
+  timeout  = 30
+
+  config = {
+    api_assertions = {
+      logic = "AND"
+      checks = [
+        {
+          property   = "$.status"
+          comparison = "equals"
+          target     = jsonencode("ok")
+        },
+        {
+          property   = "$.count"
+          comparison = "greater_than"
+          target     = jsonencode(0)
+        },
+      ]
+    }
+  }
+}
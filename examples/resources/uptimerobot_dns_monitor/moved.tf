@@ -0,0 +1,19 @@
+# Previously:
+#
+# resource "uptimerobot_monitor" "records" {
+#   type = "DNS"
+#   ...
+# }
+
+moved {
+  from = uptimerobot_monitor.records
+  to   = uptimerobot_dns_monitor.records
+}
+
+resource "uptimerobot_dns_monitor" "records" {
+  name     = "example.com records"
+  url      = "example.com"
+  interval = 300
+
+  config = {}
+}
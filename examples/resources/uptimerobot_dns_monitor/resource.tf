@@ -0,0 +1,12 @@
+resource "uptimerobot_dns_monitor" "records" {
+  name     = "example.com records"
+  url      = "example.com"
+  interval = 300
+
+  config = {
+    dns_records = {
+      # Omit a record list to preserve it on the server; set [] to clear it.
+      a = ["93.184.216.34"]
+    }
+  }
+}
@@ -0,0 +1,17 @@
+# Previously:
+#
+# resource "uptimerobot_monitor" "backup" {
+#   type = "HEARTBEAT"
+#   ...
+# }
+
+moved {
+  from = uptimerobot_monitor.backup
+  to   = uptimerobot_heartbeat_monitor.backup
+}
+
+resource "uptimerobot_heartbeat_monitor" "backup" {
+  name         = "Nightly backup"
+  interval     = 86400
+  grace_period = 3600
+}
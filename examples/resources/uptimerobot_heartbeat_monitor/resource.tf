@@ -0,0 +1,10 @@
+resource "uptimerobot_heartbeat_monitor" "backup" {
+  name         = "Nightly backup"
+  interval     = 86400
+  grace_period = 3600
+
+  tags = ["backup"]
+}
+
+# Have the job request the generated URL when it finishes:
+# uptimerobot_heartbeat_monitor.backup.url
@@ -0,0 +1,17 @@
+# Previously:
+#
+# resource "uptimerobot_monitor" "website" {
+#   type = "HTTP"
+#   ...
+# }
+
+moved {
+  from = uptimerobot_monitor.website
+  to   = uptimerobot_http_monitor.website
+}
+
+resource "uptimerobot_http_monitor" "website" {
+  name     = "Website"
+  url      = "https://example.com"
+  interval = 300
+}
@@ -0,0 +1,15 @@
+resource "uptimerobot_http_monitor" "website" {
+  name     = "Website"
+  url      = "https://example.com"
+  interval = 300
+  timeout  = 30
+
+  success_http_response_codes = ["2xx", "3xx"]
+  ssl_expiration_reminder     = true
+
+  config = {
+    ssl_expiration_period_days = [7, 14, 30]
+  }
+
+  tags = ["production", "web"]
+}
@@ -0,0 +1,20 @@
+# Previously:
+#
+# resource "uptimerobot_monitor" "api_health" {
+#   type = "KEYWORD"
+#   ...
+# }
+
+moved {
+  from = uptimerobot_monitor.api_health
+  to   = uptimerobot_keyword_monitor.api_health
+}
+
+resource "uptimerobot_keyword_monitor" "api_health" {
+  name              = "API Health Check"
+  url               = "https://api.example.com/health"
+  interval          = 60
+  keyword_type      = "ALERT_NOT_EXISTS"
+  keyword_value     = "healthy"
+  keyword_case_type = "CaseInsensitive"
+}
@@ -0,0 +1,11 @@
+resource "uptimerobot_keyword_monitor" "api_health" {
+  name     = "API Health Check"
+  url      = "https://api.example.com/health"
+  interval = 60
+
+  keyword_type      = "ALERT_NOT_EXISTS"
+  keyword_value     = "healthy"
+  keyword_case_type = "CaseInsensitive"
+
+  tags = ["api", "critical"]
+}
@@ -0,0 +1,22 @@
+# Previously, with louy/uptimerobot:
+#
+# resource "uptimerobot_maintenance_window" "backups" {
+#   friendly_name = "Nightly backups"
+#   type          = "weekly"
+#   value         = "1-3-5"
+#   start_time    = "02:30"
+#   duration      = 60
+# }
+
+moved {
+  from = uptimerobot_maintenance_window.backups
+  to   = uptimerobot_maintenance_window.nightly_backups
+}
+
+resource "uptimerobot_maintenance_window" "nightly_backups" {
+  name     = "Nightly backups"
+  interval = "weekly"
+  days     = [1, 3, 5]
+  time     = "02:30:00"
+  duration = 60
+}
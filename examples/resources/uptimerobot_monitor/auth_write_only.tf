@@ -0,0 +1,32 @@
+# Requires Terraform 1.11 or later. Write-only values are never stored in
+# state; bump the *_wo_version attributes to send new values.
+resource "uptimerobot_monitor" "protected_api_wo" {
+  name     = "Protected API Endpoint"
+  type     = "HTTP"
+  url      = "https://api.example.com/protected"
+  interval = 300
+
+  auth_type                = "HTTP_BASIC"
+  http_username            = "monitor_user"
+  http_password_wo         = var.monitor_password_wo
+  http_password_wo_version = 1
+
+  custom_http_headers_wo = {
+    "authorization" = "Bearer ${var.api_token_wo}"
+  }
+  custom_http_headers_wo_version = 1
+}
+
+variable "monitor_password_wo" {
+  description = "Password for monitor authentication"
+  type        = string
+  sensitive   = true
+  ephemeral   = true
+}
+
+variable "api_token_wo" {
+  description = "Bearer token sent with every check"
+  type        = string
+  sensitive   = true
+  ephemeral   = true
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    uptimerobot = {
+      source = "uptimerobot/uptimerobot"
+    }
+    # Keep the community provider until the move has been applied.
+    legacy = {
+      source = "louy/uptimerobot"
+    }
+  }
+}
+
+# Previously, with louy/uptimerobot:
+#
+# resource "uptimerobot_monitor" "web" {
+#   friendly_name = "Website"
+#   type          = "keyword"
+#   url           = "https://example.com"
+#   keyword_type  = "not exists"
+#   keyword_value = "error"
+#   interval      = 300
+# }
+
+moved {
+  from = uptimerobot_monitor.web
+  to   = uptimerobot_monitor.website
+}
+
+resource "uptimerobot_monitor" "website" {
+  name          = "Website"
+  type          = "KEYWORD"
+  url           = "https://example.com"
+  keyword_type  = "ALERT_NOT_EXISTS"
+  keyword_value = "error"
+  interval      = 300
+}
@@ -0,0 +1,10 @@
+resource "uptimerobot_monitor_group" "payments" {
+  name = "Payments"
+
+  # The group owns its full membership. Monitors assigned to this group
+  # outside this list are moved back to the default group on apply.
+  monitor_ids = [
+    tonumber(uptimerobot_monitor.checkout.id),
+    tonumber(uptimerobot_monitor.billing.id),
+  ]
+}
@@ -0,0 +1,9 @@
+resource "uptimerobot_monitor_group" "shared" {
+  name = "Shared Platform"
+}
+
+# Each team module adds its own monitors without owning the whole group.
+resource "uptimerobot_monitor_group_membership" "checkout" {
+  group_id   = tonumber(uptimerobot_monitor_group.shared.id)
+  monitor_id = tonumber(uptimerobot_monitor.checkout.id)
+}
@@ -0,0 +1,17 @@
+# Previously:
+#
+# resource "uptimerobot_monitor" "gateway" {
+#   type = "PING"
+#   ...
+# }
+
+moved {
+  from = uptimerobot_monitor.gateway
+  to   = uptimerobot_ping_monitor.gateway
+}
+
+resource "uptimerobot_ping_monitor" "gateway" {
+  name     = "Gateway"
+  url      = "gateway.example.com"
+  interval = 300
+}
@@ -0,0 +1,11 @@
+resource "uptimerobot_ping_monitor" "gateway" {
+  name     = "Gateway"
+  url      = "gateway.example.com"
+  interval = 300
+
+  config = {
+    ip_version = "ipv4Only"
+  }
+
+  tags = ["network"]
+}
@@ -0,0 +1,18 @@
+# Previously:
+#
+# resource "uptimerobot_monitor" "database" {
+#   type = "PORT"
+#   ...
+# }
+
+moved {
+  from = uptimerobot_monitor.database
+  to   = uptimerobot_port_monitor.database
+}
+
+resource "uptimerobot_port_monitor" "database" {
+  name     = "Database Port Check"
+  url      = "db.example.com"
+  port     = 5432
+  interval = 300
+}
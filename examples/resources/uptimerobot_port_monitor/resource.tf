@@ -0,0 +1,9 @@
+resource "uptimerobot_port_monitor" "database" {
+  name     = "Database Port Check"
+  url      = "db.example.com"
+  port     = 5432
+  interval = 300
+  timeout  = 10
+
+  tags = ["database", "infrastructure"]
+}
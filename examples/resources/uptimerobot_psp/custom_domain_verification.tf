@@ -0,0 +1,21 @@
+resource "uptimerobot_psp" "verified_domain" {
+  name          = "Example.com Status"
+  custom_domain = "status.example.com"
+
+  # Fail the apply if the domain is not verified within 15 minutes.
+  wait_for_custom_domain_verification = "15m"
+}
+
+# The record must not reference uptimerobot_psp.verified_domain, otherwise
+# Terraform creates it only after the PSP has finished waiting for verification.
+resource "aws_route53_record" "status" {
+  zone_id = var.route53_zone_id
+  name    = "status.example.com"
+  type    = "CNAME"
+  ttl     = 300
+  records = ["stats.uptimerobot.com"]
+}
+
+output "status_page_dns_records" {
+  value = uptimerobot_psp.verified_domain.custom_domain_dns_records
+}
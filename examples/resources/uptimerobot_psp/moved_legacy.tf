@@ -0,0 +1,18 @@
+# Previously, with louy/uptimerobot:
+#
+# resource "uptimerobot_status_page" "status" {
+#   friendly_name = "Status"
+#   sort          = "down-up-paused"
+#   monitors      = [0]
+# }
+
+moved {
+  from = uptimerobot_status_page.status
+  to   = uptimerobot_psp.status
+}
+
+resource "uptimerobot_psp" "status" {
+  name              = "Status"
+  monitor_sort      = "status_down_up_paused"
+  auto_add_monitors = true
+}
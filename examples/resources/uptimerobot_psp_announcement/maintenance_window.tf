@@ -0,0 +1,18 @@
+resource "uptimerobot_maintenance_window" "weekly_patching" {
+  name     = "Weekly patching"
+  interval = "weekly"
+  time     = "02:00:00"
+  duration = 60
+  days     = [7]
+}
+
+# start_date, end_date, status, and type are derived from the window. The API
+# reads the window's time in the account time zone; time_zone overrides the
+# zone the API reports for the account.
+resource "uptimerobot_psp_announcement" "weekly_patching" {
+  psp_id                = tonumber(uptimerobot_psp.public_status.id)
+  title                 = "Weekly patching"
+  content               = "Some services may be briefly unavailable while we apply updates."
+  maintenance_window_id = tonumber(uptimerobot_maintenance_window.weekly_patching.id)
+  time_zone             = "Europe/Berlin"
+}
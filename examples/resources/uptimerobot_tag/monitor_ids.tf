@@ -0,0 +1,11 @@
+resource "uptimerobot_tag" "payments" {
+  name  = "payments"
+  color = "#2E8B57"
+
+  # The tag owns its full monitor assignment. The tag is removed from
+  # monitors that carry it but are not listed here.
+  monitor_ids = [
+    tonumber(uptimerobot_monitor.checkout.id),
+    tonumber(uptimerobot_monitor.billing.id),
+  ]
+}
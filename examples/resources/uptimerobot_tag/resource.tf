@@ -0,0 +1,4 @@
+resource "uptimerobot_tag" "production" {
+  name  = "production"
+  color = "#1E90FF"
+}
@@ -0,0 +1,24 @@
+# Previously:
+#
+# resource "uptimerobot_monitor" "dns" {
+#   type = "UDP"
+#   ...
+# }
+
+moved {
+  from = uptimerobot_monitor.dns
+  to   = uptimerobot_udp_monitor.dns
+}
+
+resource "uptimerobot_udp_monitor" "dns" {
+  name     = "UDP Service Check"
+  url      = "dns.google"
+  port     = 53
+  interval = 300
+
+  config = {
+    udp = {
+      packet_loss_threshold = 50
+    }
+  }
+}
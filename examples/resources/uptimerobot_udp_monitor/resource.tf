@@ -0,0 +1,15 @@
+resource "uptimerobot_udp_monitor" "dns" {
+  name     = "UDP Service Check"
+  url      = "dns.google"
+  port     = 53
+  interval = 300
+
+  config = {
+    udp = {
+      payload               = "ping"
+      packet_loss_threshold = 50
+    }
+  }
+
+  tags = ["udp", "network"]
+}
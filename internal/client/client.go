@@ -23,12 +23,18 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	defaultBaseURL = "https://api.uptimerobot.com/v3"
 	defaultTimeout = 30 * time.Second
 
+	tracerName = "github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+
 	transientMaxAttempts = 4
 	rateLimitMaxAttempts = 8
 	requestBaseBackoff   = 200 * time.Millisecond
@@ -45,6 +51,9 @@ type Client struct {
 	extraHeaders map[string]string
 	rateLimitMu  sync.Mutex
 	rateLimitAt  time.Time
+	readOnly     bool
+	// tracerProvider overrides the global OpenTelemetry tracer provider.
+	tracerProvider trace.TracerProvider
 	// debug      bool
 }
 
@@ -85,6 +94,21 @@ func NewClient(apiKey string) *Client {
 	return client
 }
 
+// SetTracerProvider sets the OpenTelemetry tracer provider used for request
+// spans. By default the global tracer provider is used, which does nothing
+// unless tracing is enabled.
+func (c *Client) SetTracerProvider(tp trace.TracerProvider) {
+	c.tracerProvider = tp
+}
+
+func (c *Client) tracer() trace.Tracer {
+	tp := c.tracerProvider
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return tp.Tracer(tracerName)
+}
+
 func (c *Client) ApiKey() string {
 	return c.apiKey
 }
@@ -132,6 +156,26 @@ func (c *Client) SetUserAgent(ua string) {
 	c.userAgent = ua
 }
 
+// ErrReadOnly is returned for requests other than GET while the client is
+// read-only. Those requests never reach the network.
+var ErrReadOnly = errors.New("read-only mode")
+
+// SetReadOnly makes the client refuse every request other than GET.
+func (c *Client) SetReadOnly(readOnly bool) {
+	c.readOnly = readOnly
+}
+
+func (c *Client) ReadOnly() bool {
+	return c.readOnly
+}
+
+func (c *Client) checkReadOnly(method, path string) error {
+	if !c.readOnly || method == http.MethodGet {
+		return nil
+	}
+	return fmt.Errorf("%w: refusing %s %s, only GET requests are sent", ErrReadOnly, method, path)
+}
+
 func (c *Client) AddHeader(k, v string) {
 	if c.extraHeaders == nil {
 		c.extraHeaders = map[string]string{}
@@ -156,6 +200,9 @@ func (c *Client) doMetaRequest(ctx context.Context, method, path string, body in
 }
 
 func (c *Client) doRequestWithBaseURL(ctx context.Context, baseURL, method, path string, body interface{}) ([]byte, error) {
+	if err := c.checkReadOnly(method, path); err != nil {
+		return nil, err
+	}
 	jsonBody, err := marshalJSONBody(method, body)
 	if err != nil {
 		return nil, err
@@ -273,6 +320,20 @@ func (c *Client) doJSONAttempt(
 	}
 	requestURL := req.URL.String()
 
+	ctx, span := c.tracer().Start(ctx, "uptimerobot "+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", method),
+			attribute.String("url.full", requestURL),
+			attribute.Bool("uptimerobot.idempotent", idempotent),
+		),
+	)
+	defer span.End()
+	if attempt > 0 {
+		span.SetAttributes(attribute.Int("http.request.resend_count", attempt))
+	}
+	req = req.WithContext(ctx)
+
 	tflog.Debug(ctx, "uptimerobot http request", map[string]any{
 		"attempt": attempt + 1,
 		"method":  method,
@@ -292,9 +353,13 @@ func (c *Client) doJSONAttempt(
 			"error":       err.Error(),
 			"idempotent":  idempotent,
 		})
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "request failed")
 		return httpAttemptResult{}, isTransientNetErr(err), wrapped
 	}
 
+	setResponseSpanAttributes(span, resp)
+
 	respBody, readErr := io.ReadAll(resp.Body)
 	_ = resp.Body.Close()
 	if readErr != nil {
@@ -307,6 +372,8 @@ func (c *Client) doJSONAttempt(
 			"duration_ms": time.Since(start).Milliseconds(),
 			"error":       readErr.Error(),
 		})
+		span.RecordError(readErr)
+		span.SetStatus(codes.Error, "read body failed")
 		return httpAttemptResult{}, true, wrapped
 	}
 
@@ -329,6 +396,25 @@ func (c *Client) doJSONAttempt(
 	}, false, nil
 }
 
+// setResponseSpanAttributes records the status and rate limit headers of
+// resp on an attempt span.
+func setResponseSpanAttributes(span trace.Span, resp *http.Response) {
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	for header, key := range map[string]string{
+		"X-RateLimit-Limit":     "uptimerobot.rate_limit.limit",
+		"X-RateLimit-Remaining": "uptimerobot.rate_limit.remaining",
+		"X-RateLimit-Reset":     "uptimerobot.rate_limit.reset",
+		"Retry-After":           "uptimerobot.rate_limit.retry_after",
+	} {
+		if v := strings.TrimSpace(resp.Header.Get(header)); v != "" {
+			span.SetAttributes(attribute.String(key, v))
+		}
+	}
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+}
+
 func (c *Client) newJSONRequest(ctx context.Context, baseURL, method, path string, jsonBody []byte) (*http.Request, error) {
 	var reqBody io.Reader
 	if jsonBody != nil {
@@ -414,6 +500,10 @@ func (c *Client) handleRateLimitResponse(
 			"rate_remaining": result.headers.Get("X-RateLimit-Remaining"),
 			"rate_reset":     result.headers.Get("X-RateLimit-Reset"),
 		})
+		trace.SpanFromContext(ctx).AddEvent("uptimerobot.rate_limit.retry", trace.WithAttributes(
+			attribute.Int("http.request.resend_count", attempt+1),
+			attribute.String("uptimerobot.rate_limit.delay", delay.String()),
+		))
 		if err := sleepContext(ctx, delay); err != nil {
 			return false, err
 		}
@@ -463,6 +553,9 @@ func (c *Client) doMultipartRequest(
 	fields map[string]string,
 	files map[string]string,
 ) ([]byte, error) {
+	if err := c.checkReadOnly(method, path); err != nil {
+		return nil, err
+	}
 	var reqBody bytes.Buffer
 	writer := multipart.NewWriter(&reqBody)
 
@@ -770,6 +863,9 @@ func (c *Client) waitForRateLimit(ctx context.Context) error {
 		if wait <= 0 {
 			return nil
 		}
+		trace.SpanFromContext(ctx).AddEvent("uptimerobot.rate_limit.wait", trace.WithAttributes(
+			attribute.String("uptimerobot.rate_limit.delay", wait.String()),
+		))
 
 		if err := sleepContext(ctx, wait); err != nil {
 			return fmt.Errorf("rate limit wait cancelled: %w", err)
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -10,6 +11,11 @@ import (
 	"sync"
 	"testing"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func mustMap(t *testing.T, v any) map[string]any {
@@ -163,6 +169,73 @@ func TestClient_RetriesPostOnRateLimit(t *testing.T) {
 	}
 }
 
+func TestClient_TracesRequestAttempts(t *testing.T) {
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("X-RateLimit-Remaining", "9")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := trace.NewTracerProvider(trace.WithSpanProcessor(recorder))
+	c := NewClient("test-key")
+	c.SetBaseURL(srv.URL)
+	c.SetTracerProvider(tp)
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "create")
+	if _, err := c.doRequest(ctx, http.MethodPost, "/monitors", map[string]string{"name": "test"}); err != nil {
+		t.Fatal(err)
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("expected 2 attempt spans and the parent, got %d", len(spans))
+	}
+	attrs := func(i int) map[attribute.Key]attribute.Value {
+		m := map[attribute.Key]attribute.Value{}
+		for _, kv := range spans[i].Attributes() {
+			m[kv.Key] = kv.Value
+		}
+		return m
+	}
+
+	first, second := attrs(0), attrs(1)
+	if first["http.response.status_code"].AsInt64() != http.StatusTooManyRequests ||
+		first["uptimerobot.rate_limit.remaining"].AsString() != "0" ||
+		first["uptimerobot.rate_limit.retry_after"].AsString() != "0" ||
+		spans[0].Status().Code != codes.Error {
+		t.Fatalf("unexpected first attempt span: %v %v", first, spans[0].Status())
+	}
+	if _, ok := first["http.request.resend_count"]; ok {
+		t.Fatalf("first attempt must not carry a resend count: %v", first)
+	}
+	if second["http.response.status_code"].AsInt64() != http.StatusOK ||
+		second["http.request.resend_count"].AsInt64() != 1 ||
+		second["http.request.method"].AsString() != http.MethodPost ||
+		spans[1].Status().Code == codes.Error {
+		t.Fatalf("unexpected second attempt span: %v %v", second, spans[1].Status())
+	}
+	for _, s := range spans[:2] {
+		if s.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Fatalf("attempt span %q is not a child of the operation span", s.Name())
+		}
+	}
+
+	events := spans[2].Events()
+	if len(events) != 1 || events[0].Name != "uptimerobot.rate_limit.retry" {
+		t.Fatalf("expected a rate limit retry event on the parent span, got %v", events)
+	}
+}
+
 func TestClient_RateLimitWaitHonorsContextCancellation(t *testing.T) {
 	requests := 0
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -362,3 +435,41 @@ func TestSanitizeJSON_ClipsLargeOutput_NonSensitive(t *testing.T) {
 	}
 	// Don’t assert JSON validity here, clipping intentionally produces a truncated string.
 }
+
+func TestClient_ReadOnlyRefusesWritesBeforeTheNetwork(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var methods []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		methods = append(methods, r.Method)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("test-key")
+	c.SetBaseURL(srv.URL)
+	c.SetReadOnly(true)
+
+	if _, err := c.doRequest(context.Background(), http.MethodGet, "/monitors/1", nil); err != nil {
+		t.Fatalf("GET in read-only mode: %v", err)
+	}
+	for _, method := range []string{http.MethodPost, http.MethodPatch, http.MethodDelete} {
+		_, err := c.doRequest(context.Background(), method, "/monitors/1", map[string]any{"x": 1})
+		if !errors.Is(err, ErrReadOnly) {
+			t.Fatalf("%s: expected ErrReadOnly, got %v", method, err)
+		}
+	}
+	if _, err := c.doMultipartRequest(context.Background(), http.MethodPost, "/psps", nil, nil); !errors.Is(err, ErrReadOnly) {
+		t.Fatalf("multipart: expected ErrReadOnly, got %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(methods) != 1 || methods[0] != http.MethodGet {
+		t.Fatalf("expected only the GET to reach the server, got %v", methods)
+	}
+}
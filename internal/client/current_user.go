@@ -9,11 +9,15 @@ import (
 
 // CurrentUser represents account metadata returned by the public API.
 type CurrentUser struct {
-	Email              string                  `json:"email"`
-	FullName           string                  `json:"fullName"`
-	MonitorsCount      int64                   `json:"monitorsCount"`
-	MonitorLimit       int64                   `json:"monitorLimit"`
-	SMSCredits         int64                   `json:"smsCredits"`
+	Email         string `json:"email"`
+	FullName      string `json:"fullName"`
+	MonitorsCount int64  `json:"monitorsCount"`
+	MonitorLimit  int64  `json:"monitorLimit"`
+	SMSCredits    int64  `json:"smsCredits"`
+	// TimeZone is the IANA time zone of the account, which maintenance
+	// window dates and times are read in. It is empty when the API does not
+	// report it.
+	TimeZone           string                  `json:"timezone"`
 	ActiveSubscription CurrentUserSubscription `json:"activeSubscription"`
 }
 
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -14,13 +17,38 @@ type APIError struct {
 	Message    string
 	Code       string
 	Body       string
+	// FieldErrors holds the per-field entries of a validation error response.
+	FieldErrors []FieldError
+}
+
+// FieldError is a validation error the API reported for a single request field.
+type FieldError struct {
+	// Field is the API field path, e.g. "friendlyName" or "assignedAlertContacts[2].threshold".
+	Field   string
+	Message string
 }
 
 type apiErrorPayload struct {
-	Message string `json:"message"`
-	Code    string `json:"code"`
-	Error   string `json:"error"`
-	Detail  string `json:"detail"`
+	Message     json.RawMessage `json:"message"`
+	Code        json.RawMessage `json:"code"`
+	Error       string          `json:"error"`
+	Detail      string          `json:"detail"`
+	Errors      json.RawMessage `json:"errors"`
+	FieldErrors json.RawMessage `json:"fieldErrors"`
+	Details     json.RawMessage `json:"details"`
+}
+
+// apiFieldErrorPayload covers the object shapes used for validation entries:
+// {field, message}, {property, constraints, children} and {path, messages}.
+type apiFieldErrorPayload struct {
+	Field       string                 `json:"field"`
+	Property    string                 `json:"property"`
+	Path        json.RawMessage        `json:"path"`
+	Param       string                 `json:"param"`
+	Message     string                 `json:"message"`
+	Messages    []string               `json:"messages"`
+	Constraints map[string]string      `json:"constraints"`
+	Children    []apiFieldErrorPayload `json:"children"`
 }
 
 func newAPIError(statusCode int, body []byte) *APIError {
@@ -38,7 +66,8 @@ func newAPIError(statusCode int, body []byte) *APIError {
 		return apiErr
 	}
 
-	msg := strings.TrimSpace(payload.Message)
+	messages := rawStrings(payload.Message)
+	msg := strings.TrimSpace(strings.Join(messages, "; "))
 	if msg == "" {
 		msg = strings.TrimSpace(payload.Error)
 	}
@@ -48,22 +77,212 @@ func newAPIError(statusCode int, body []byte) *APIError {
 	if msg != "" {
 		apiErr.Message = msg
 	}
-	if payload.Code != "" {
-		apiErr.Code = strings.TrimSpace(payload.Code)
+	if code := rawScalar(payload.Code); code != "" {
+		apiErr.Code = code
+	}
+
+	for _, raw := range []json.RawMessage{payload.Errors, payload.FieldErrors, payload.Details} {
+		apiErr.FieldErrors = append(apiErr.FieldErrors, parseFieldErrors(raw)...)
+	}
+	// Without a structured errors list, fall back to messages that name their field.
+	if len(apiErr.FieldErrors) == 0 {
+		for _, m := range messages {
+			if fe, ok := fieldErrorFromMessage(m); ok {
+				apiErr.FieldErrors = append(apiErr.FieldErrors, fe)
+			}
+		}
 	}
 
 	return apiErr
 }
 
+// rawStrings decodes a JSON string or array of strings.
+func rawStrings(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		if strings.TrimSpace(single) == "" {
+			return nil
+		}
+		return []string{strings.TrimSpace(single)}
+	}
+	var many []string
+	if err := json.Unmarshal(raw, &many); err == nil {
+		out := make([]string, 0, len(many))
+		for _, m := range many {
+			if m = strings.TrimSpace(m); m != "" {
+				out = append(out, m)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// rawScalar decodes a JSON string or number as a string.
+func rawScalar(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return strings.TrimSpace(s)
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n.String()
+	}
+	return ""
+}
+
+func parseFieldErrors(raw json.RawMessage) []FieldError {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var entries []apiFieldErrorPayload
+	if err := json.Unmarshal(raw, &entries); err == nil {
+		var out []FieldError
+		for _, entry := range entries {
+			out = append(out, entry.fieldErrors("")...)
+		}
+		return out
+	}
+
+	// {"friendlyName": ["must not be empty"], "interval": "must be >= 30"}
+	var byField map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &byField); err != nil {
+		return nil
+	}
+	fields := make([]string, 0, len(byField))
+	for field := range byField {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var out []FieldError
+	for _, field := range fields {
+		for _, m := range rawStrings(byField[field]) {
+			out = append(out, FieldError{Field: normalizeAPIFieldPath(field), Message: m})
+		}
+	}
+	return out
+}
+
+func (p apiFieldErrorPayload) fieldErrors(parent string) []FieldError {
+	field := p.Field
+	if field == "" {
+		field = p.Property
+	}
+	if field == "" {
+		field = rawFieldPath(p.Path)
+	}
+	if field == "" {
+		field = p.Param
+	}
+	if parent != "" && field != "" {
+		field = parent + "." + field
+	} else if parent != "" {
+		field = parent
+	}
+	field = normalizeAPIFieldPath(field)
+
+	var messages []string
+	if m := strings.TrimSpace(p.Message); m != "" {
+		messages = append(messages, m)
+	}
+	messages = append(messages, p.Messages...)
+	keys := make([]string, 0, len(p.Constraints))
+	for key := range p.Constraints {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		messages = append(messages, p.Constraints[key])
+	}
+
+	var out []FieldError
+	for _, m := range messages {
+		out = append(out, FieldError{Field: field, Message: strings.TrimSpace(m)})
+	}
+	for _, child := range p.Children {
+		out = append(out, child.fieldErrors(field)...)
+	}
+	return out
+}
+
+// rawFieldPath decodes a path given as a string or as an array of keys and indexes.
+func rawFieldPath(raw json.RawMessage) string {
+	if s := rawScalar(raw); s != "" {
+		return s
+	}
+	var parts []json.RawMessage
+	if err := json.Unmarshal(raw, &parts); err != nil {
+		return ""
+	}
+	segments := make([]string, 0, len(parts))
+	for _, part := range parts {
+		segments = append(segments, rawScalar(part))
+	}
+	return strings.Join(segments, ".")
+}
+
+// normalizeAPIFieldPath writes numeric segments as indexes:
+// "assignedAlertContacts.2.threshold" becomes "assignedAlertContacts[2].threshold".
+func normalizeAPIFieldPath(field string) string {
+	field = strings.TrimSpace(field)
+	if field == "" {
+		return ""
+	}
+	parts := strings.Split(field, ".")
+	var b strings.Builder
+	for i, part := range parts {
+		if _, err := strconv.Atoi(part); err == nil && i > 0 {
+			b.WriteString("[" + part + "]")
+			continue
+		}
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// validationMessagePattern matches messages that start with the offending field,
+// e.g. "friendlyName must be shorter than or equal to 250 characters".
+var validationMessagePattern = regexp.MustCompile(`^([a-z][A-Za-z0-9_]*(?:\[\d+\]|\.[A-Za-z0-9_]+)*) ((?:must|should|is|has|contains) .+)$`)
+
+func fieldErrorFromMessage(message string) (FieldError, bool) {
+	match := validationMessagePattern.FindStringSubmatch(strings.TrimSpace(message))
+	if match == nil {
+		return FieldError{}, false
+	}
+	return FieldError{Field: normalizeAPIFieldPath(match[1]), Message: match[2]}, true
+}
+
+func (e FieldError) String() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
 func (e *APIError) Error() string {
 	if e == nil {
 		return "API request failed"
 	}
 	if e.Message != "" {
+		msg := e.Message
+		if fields := e.unreportedFieldErrors(); fields != "" {
+			msg += " [" + fields + "]"
+		}
 		if e.Code != "" {
-			return fmt.Sprintf("API request failed with status %d: %s (code %s)", e.StatusCode, e.Message, e.Code)
+			return fmt.Sprintf("API request failed with status %d: %s (code %s)", e.StatusCode, msg, e.Code)
 		}
-		return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Message)
+		return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, msg)
 	}
 	if e.Body != "" {
 		return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
@@ -71,6 +290,18 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("API request failed with status %d", e.StatusCode)
 }
 
+// unreportedFieldErrors lists the field errors that the message does not already spell out.
+func (e *APIError) unreportedFieldErrors() string {
+	var parts []string
+	for _, fe := range e.FieldErrors {
+		if strings.Contains(e.Message, fe.Message) {
+			continue
+		}
+		parts = append(parts, fe.String())
+	}
+	return strings.Join(parts, "; ")
+}
+
 // AsAPIError extracts an APIError from a wrapped error.
 func AsAPIError(err error) (*APIError, bool) {
 	if err == nil {
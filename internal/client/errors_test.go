@@ -0,0 +1,101 @@
+package client
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewAPIErrorFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		body        string
+		wantMessage string
+		wantFields  []FieldError
+	}{
+		{
+			name:        "errors list",
+			body:        `{"message":"Validation failed","code":"000-001","errors":[{"field":"friendlyName","message":"must not be empty"},{"field":"assignedAlertContacts.2.threshold","message":"must be >= 0"}]}`,
+			wantMessage: "Validation failed",
+			wantFields: []FieldError{
+				{Field: "friendlyName", Message: "must not be empty"},
+				{Field: "assignedAlertContacts[2].threshold", Message: "must be >= 0"},
+			},
+		},
+		{
+			name:        "class-validator children",
+			body:        `{"message":"Bad Request","errors":[{"property":"config","children":[{"property":"dnsRecords","children":[{"property":"A","constraints":{"isArray":"A must be an array"}}]}]}]}`,
+			wantMessage: "Bad Request",
+			wantFields: []FieldError{
+				{Field: "config.dnsRecords.A", Message: "A must be an array"},
+			},
+		},
+		{
+			name:        "errors keyed by field",
+			body:        `{"message":"Invalid request","errors":{"interval":"must be at least 30","friendlyName":["is required","is too short"]}}`,
+			wantMessage: "Invalid request",
+			wantFields: []FieldError{
+				{Field: "friendlyName", Message: "is required"},
+				{Field: "friendlyName", Message: "is too short"},
+				{Field: "interval", Message: "must be at least 30"},
+			},
+		},
+		{
+			name:        "path array",
+			body:        `{"message":"Invalid","details":[{"path":["assignedAlertContacts",0,"recurrence"],"message":"must be a number"}]}`,
+			wantMessage: "Invalid",
+			wantFields: []FieldError{
+				{Field: "assignedAlertContacts[0].recurrence", Message: "must be a number"},
+			},
+		},
+		{
+			name:        "message list naming fields",
+			body:        `{"statusCode":400,"message":["friendlyName must be shorter than or equal to 250 characters","Unsupported monitor type"],"error":"Bad Request"}`,
+			wantMessage: "friendlyName must be shorter than or equal to 250 characters; Unsupported monitor type",
+			wantFields: []FieldError{
+				{Field: "friendlyName", Message: "must be shorter than or equal to 250 characters"},
+			},
+		},
+		{
+			name:        "plain message",
+			body:        `{"message":"Monitor not found","code":"000-004"}`,
+			wantMessage: "Monitor not found",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			apiErr := newAPIError(http.StatusBadRequest, []byte(tt.body))
+			if apiErr.Message != tt.wantMessage {
+				t.Fatalf("expected message %q, got %q", tt.wantMessage, apiErr.Message)
+			}
+			if !reflect.DeepEqual(apiErr.FieldErrors, tt.wantFields) {
+				t.Fatalf("expected field errors %#v, got %#v", tt.wantFields, apiErr.FieldErrors)
+			}
+		})
+	}
+}
+
+func TestAPIErrorStringIncludesFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	apiErr := newAPIError(http.StatusBadRequest, []byte(`{"message":"Validation failed","code":"000-001","errors":[{"field":"interval","message":"must be at least 30"}]}`))
+	want := "API request failed with status 400: Validation failed [interval: must be at least 30] (code 000-001)"
+	if got := apiErr.Error(); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	numeric := newAPIError(http.StatusBadRequest, []byte(`{"message":"friendlyName must not be empty","code":1}`))
+	if numeric.Code != "1" {
+		t.Fatalf("expected numeric code to decode, got %q", numeric.Code)
+	}
+	if got := numeric.Error(); strings.Contains(got, "[") {
+		t.Fatalf("expected field errors already in the message not to repeat, got %q", got)
+	}
+}
@@ -188,6 +188,12 @@ func (c *Client) GetIntegration(ctx context.Context, id int64) (*Integration, er
 	return &integration, nil
 }
 
+// TestIntegration sends a test notification through an integration.
+func (c *Client) TestIntegration(ctx context.Context, id int64) error {
+	_, err := c.doRequest(ctx, "POST", fmt.Sprintf("/integrations/%d/test", id), map[string]any{})
+	return err
+}
+
 // ListIntegrations lists integrations. If cursorID is nil, the first page is returned.
 func (c *Client) ListIntegrations(ctx context.Context, cursorID *int64) (*IntegrationListResponse, error) {
 	path := "/integrations"
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"html"
 	"net/url"
 	"sort"
 	"strconv"
@@ -476,6 +477,62 @@ func (c *Client) UpdateMonitor(ctx context.Context, id int64, req *UpdateMonitor
 	return &monitor, nil
 }
 
+// UpdateRequestFromMonitor returns an update request with the fields of m that
+// UpdateMonitorRequest always sends: name, URL, type, interval and the
+// reminder and redirect flags. Updates that change a single setting start from
+// it, so the PATCH does not reset the others.
+func UpdateRequestFromMonitor(m *Monitor) *UpdateMonitorRequest {
+	req := &UpdateMonitorRequest{
+		Name:                     UnescapeHTML(m.Name),
+		Type:                     MonitorType(m.Type),
+		Interval:                 m.Interval,
+		SSLExpirationReminder:    m.SSLExpirationReminder,
+		DomainExpirationReminder: m.DomainExpirationReminder,
+		FollowRedirections:       m.FollowRedirections,
+	}
+	if !strings.EqualFold(m.Type, string(MonitorTypeHeartbeat)) {
+		req.URL = UnescapeHTML(m.URL)
+	}
+	return req
+}
+
+// updateMonitorFromCurrent reads the monitor, lets edit change the request
+// built from it by UpdateRequestFromMonitor and sends the update.
+func (c *Client) updateMonitorFromCurrent(ctx context.Context, id int64, edit func(req *UpdateMonitorRequest, current *Monitor)) (*Monitor, error) {
+	current, err := c.GetMonitor(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	req := UpdateRequestFromMonitor(current)
+	edit(req, current)
+	var monitor Monitor
+	if err := NewBaseCRUDOperations(c, "/monitors").doUpdate(ctx, id, req, &monitor); err != nil {
+		return nil, err
+	}
+	return &monitor, nil
+}
+
+// UnescapeHTML repeatedly applies html.UnescapeString until the value is
+// stable or a small maximum iteration count is reached. The API can return
+// monitor names and URLs escaped, or double-escaped.
+func UnescapeHTML(s string) string {
+	const maxPasses = 5
+
+	if !strings.Contains(s, "&") {
+		return s
+	}
+
+	out := s
+	for i := 0; i < maxPasses; i++ {
+		next := html.UnescapeString(out)
+		if next == out {
+			return out
+		}
+		out = next
+	}
+	return out
+}
+
 // DeleteMonitor deletes a monitor.
 func (c *Client) DeleteMonitor(ctx context.Context, id int64) error {
 	return NewBaseCRUDOperations(c, "/monitors").doDelete(ctx, id)
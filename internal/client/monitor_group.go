@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -164,6 +165,39 @@ func (c *Client) DeleteMonitorGroup(ctx context.Context, id int64, monitorsNewGr
 	return nil
 }
 
+// AssignMonitorToGroup moves a monitor into a monitor group. The update is
+// built from the monitor's current settings, so nothing else changes. Use
+// group ID 0 to move it to the default group.
+func (c *Client) AssignMonitorToGroup(ctx context.Context, monitorID, groupID int64) (*Monitor, error) {
+	group := int(groupID)
+	monitor, err := c.updateMonitorFromCurrent(ctx, monitorID, func(req *UpdateMonitorRequest, _ *Monitor) {
+		req.GroupID = &group
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign monitor %d to group %d: %w", monitorID, groupID, err)
+	}
+	return monitor, nil
+}
+
+// ListMonitorGroupMemberIDs returns the IDs of all monitors currently assigned to a monitor group.
+func (c *Client) ListMonitorGroupMemberIDs(ctx context.Context, groupID int64) ([]int64, error) {
+	monitors, err := c.GetMonitorsFiltered(ctx, MonitorListFilters{GroupID: &groupID})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(monitors))
+	for _, monitor := range monitors {
+		// Guard against the API ignoring the groupId filter.
+		if monitor.GroupID != groupID {
+			continue
+		}
+		ids = append(ids, monitor.ID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
 // WaitMonitorGroupDeleted waits until GET /monitor-groups/{id} returns 404 or 410.
 func (c *Client) WaitMonitorGroupDeleted(ctx context.Context, id int64, timeout time.Duration) error {
 	return NewBaseCRUDOperations(c, "/monitor-groups").waitDeleted(ctx, id, timeout)
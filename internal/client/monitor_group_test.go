@@ -109,6 +109,64 @@ func TestClient_DeleteMonitorGroup_DefaultMoveBehavior(t *testing.T) {
 	}
 }
 
+func TestClient_AssignMonitorToGroup_KeepsMonitorSettings(t *testing.T) {
+	t.Parallel()
+
+	c := NewClient("test-key")
+	c.httpClient = &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			switch got := req.Method + " " + req.URL.RequestURI(); got {
+			case "GET /monitors/555":
+				return jsonResponse(http.StatusOK, `{"id":555,"friendlyName":"api","type":"HEARTBEAT","interval":300,"url":"https://heartbeat.example","sslExpirationReminder":true,"groupId":7}`), nil
+			case "PATCH /monitors/555":
+			default:
+				t.Fatalf("unexpected request %s", got)
+			}
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			want := `{"friendlyName":"api","type":"HEARTBEAT","interval":300,"sslExpirationReminder":true,"domainExpirationReminder":false,"followRedirections":false,"groupId":101}`
+			if string(body) != want {
+				t.Fatalf("expected update built from the monitor, got %s", body)
+			}
+			return jsonResponse(http.StatusOK, `{"id":555,"friendlyName":"api","type":"HTTP","interval":300,"groupId":101}`), nil
+		}),
+	}
+	c.SetBaseURL("https://example.test")
+
+	monitor, err := c.AssignMonitorToGroup(context.Background(), 555, 101)
+	if err != nil {
+		t.Fatalf("AssignMonitorToGroup returned error: %v", err)
+	}
+	if monitor.ID != 555 || monitor.GroupID != 101 {
+		t.Fatalf("unexpected monitor: %#v", monitor)
+	}
+}
+
+func TestClient_ListMonitorGroupMemberIDs_FiltersByGroup(t *testing.T) {
+	t.Parallel()
+
+	c := NewClient("test-key")
+	c.httpClient = &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if got := req.Method + " " + req.URL.RequestURI(); got != "GET /monitors?groupId=101" {
+				t.Fatalf("unexpected request %s", got)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[{"id":9,"groupId":101},{"id":3,"groupId":101},{"id":4,"groupId":0}]}`), nil
+		}),
+	}
+	c.SetBaseURL("https://example.test")
+
+	ids, err := c.ListMonitorGroupMemberIDs(context.Background(), 101)
+	if err != nil {
+		t.Fatalf("ListMonitorGroupMemberIDs returned error: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != 3 || ids[1] != 9 {
+		t.Fatalf("unexpected member IDs %v", ids)
+	}
+}
+
 func TestClient_ListMonitorGroups_WithCursor(t *testing.T) {
 	t.Parallel()
 
@@ -16,6 +16,8 @@ type PSP struct {
 	ID                         int64               `json:"id"`
 	Name                       string              `json:"friendlyName"`
 	CustomDomain               *string             `json:"customDomain,omitempty"`
+	CustomDomainStatus         *string             `json:"customDomainStatus,omitempty"`
+	CustomDomainDNSRecords     []PSPDNSRecord      `json:"customDomainDnsRecords,omitempty"`
 	IsPasswordSet              bool                `json:"isPasswordSet"`
 	MonitorIDs                 []int64             `json:"monitorIds,omitempty"`
 	TagIDs                     []int64             `json:"tagIds,omitempty"`
@@ -37,6 +39,13 @@ type PSP struct {
 	CustomSettings             *CustomSettingsResp `json:"customSettings,omitempty"`
 }
 
+// PSPDNSRecord represents a DNS record required to verify a PSP custom domain.
+type PSPDNSRecord struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
 // PSPListResponse represents a paginated Public Status Page list response.
 type PSPListResponse struct {
 	Data         []PSP   `json:"data"`
@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 // UserTag represents a monitor tag returned by the public tags API.
 type UserTag struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
 }
 
 // TagListResponse represents one page of user tags.
@@ -20,6 +22,79 @@ type TagListResponse struct {
 	NextCursorID *int64    `json:"nextCursorId"`
 }
 
+// CreateTagRequest represents the request to create a tag.
+type CreateTagRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+// UpdateTagRequest represents the request to update a tag.
+type UpdateTagRequest struct {
+	Name  string `json:"name,omitempty"`
+	Color string `json:"color,omitempty"`
+}
+
+// CreateTag creates a new tag.
+func (c *Client) CreateTag(ctx context.Context, req *CreateTagRequest) (*UserTag, error) {
+	base := NewBaseCRUDOperations(c, "/tags")
+	var tag UserTag
+	if err := base.doCreate(ctx, req, &tag); err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+	return &tag, nil
+}
+
+// GetTag retrieves a tag by ID.
+func (c *Client) GetTag(ctx context.Context, id int64) (*UserTag, error) {
+	base := NewBaseCRUDOperations(c, "/tags")
+	var tag UserTag
+	if err := base.doGet(ctx, id, &tag); err != nil {
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+	return &tag, nil
+}
+
+// UpdateTag updates an existing tag.
+func (c *Client) UpdateTag(ctx context.Context, id int64, req *UpdateTagRequest) (*UserTag, error) {
+	base := NewBaseCRUDOperations(c, "/tags")
+	var tag UserTag
+	if err := base.doUpdate(ctx, id, req, &tag); err != nil {
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+	return &tag, nil
+}
+
+// DeleteTag deletes a tag. Monitors that carried the tag lose it.
+func (c *Client) DeleteTag(ctx context.Context, id int64) error {
+	return NewBaseCRUDOperations(c, "/tags").doDelete(ctx, id)
+}
+
+// WaitTagDeleted waits until GET /tags/{id} returns 404 or 410.
+func (c *Client) WaitTagDeleted(ctx context.Context, id int64, timeout time.Duration) error {
+	return NewBaseCRUDOperations(c, "/tags").waitDeleted(ctx, id, timeout)
+}
+
+// UpdateMonitorTags replaces the tag names of a monitor with what edit
+// returns for its current ones. The update is built from the monitor's
+// current settings, so nothing else changes.
+func (c *Client) UpdateMonitorTags(ctx context.Context, monitorID int64, edit func(names []string) []string) (*Monitor, error) {
+	monitor, err := c.updateMonitorFromCurrent(ctx, monitorID, func(req *UpdateMonitorRequest, current *Monitor) {
+		names := make([]string, 0, len(current.Tags))
+		for _, tag := range current.Tags {
+			names = append(names, tag.Name)
+		}
+		names = edit(names)
+		if names == nil {
+			names = []string{}
+		}
+		req.Tags = &names
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tags of monitor %d: %w", monitorID, err)
+	}
+	return monitor, nil
+}
+
 // ListTags lists user tags. If cursorID is nil, the first page is returned.
 func (c *Client) ListTags(ctx context.Context, cursorID *int64) (*TagListResponse, error) {
 	path := "/tags"
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"strings"
 	"testing"
@@ -114,3 +115,104 @@ func TestClient_ListAllTags_RejectsCursorCycle(t *testing.T) {
 		t.Fatalf("unexpected requests:\n%s", strings.Join(seen, "\n"))
 	}
 }
+
+func TestClient_TagCRUDPaths(t *testing.T) {
+	t.Parallel()
+
+	var seen []string
+
+	c := NewClient("test-key")
+	c.httpClient = &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			var body []byte
+			if req.Body != nil {
+				body, _ = io.ReadAll(req.Body)
+			}
+			seen = append(seen, strings.TrimSpace(req.Method+" "+req.URL.RequestURI()+" "+string(body)))
+
+			switch req.Method + " " + req.URL.RequestURI() {
+			case "POST /tags":
+				return jsonResponse(http.StatusCreated, `{"id":7,"name":"prod","color":"#FF0000"}`), nil
+			case "GET /tags/7":
+				return jsonResponse(http.StatusOK, `{"id":7,"name":"prod","color":"#FF0000"}`), nil
+			case "PATCH /tags/7":
+				return jsonResponse(http.StatusOK, `{"id":7,"name":"production","color":"#00FF00"}`), nil
+			case "DELETE /tags/7":
+				return jsonResponse(http.StatusNoContent, ``), nil
+			default:
+				t.Fatalf("unexpected request %s %s", req.Method, req.URL.RequestURI())
+				return nil, nil
+			}
+		}),
+	}
+	c.SetBaseURL("https://example.test")
+
+	created, err := c.CreateTag(context.Background(), &CreateTagRequest{Name: "prod", Color: "#FF0000"})
+	if err != nil {
+		t.Fatalf("CreateTag returned error: %v", err)
+	}
+	if created.ID != 7 || created.Color != "#FF0000" {
+		t.Fatalf("unexpected created tag: %#v", created)
+	}
+
+	if _, err := c.GetTag(context.Background(), 7); err != nil {
+		t.Fatalf("GetTag returned error: %v", err)
+	}
+
+	updated, err := c.UpdateTag(context.Background(), 7, &UpdateTagRequest{Name: "production", Color: "#00FF00"})
+	if err != nil {
+		t.Fatalf("UpdateTag returned error: %v", err)
+	}
+	if updated.Name != "production" {
+		t.Fatalf("unexpected updated tag: %#v", updated)
+	}
+
+	if err := c.DeleteTag(context.Background(), 7); err != nil {
+		t.Fatalf("DeleteTag returned error: %v", err)
+	}
+
+	want := []string{
+		`POST /tags {"name":"prod","color":"#FF0000"}`,
+		`GET /tags/7`,
+		`PATCH /tags/7 {"name":"production","color":"#00FF00"}`,
+		`DELETE /tags/7`,
+	}
+	if strings.Join(seen, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected requests:\n%s", strings.Join(seen, "\n"))
+	}
+}
+
+func TestClient_UpdateMonitorTags_KeepsMonitorSettings(t *testing.T) {
+	t.Parallel()
+
+	c := NewClient("test-key")
+	c.httpClient = &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			switch got := req.Method + " " + req.URL.RequestURI(); got {
+			case "GET /monitors/42":
+				return jsonResponse(http.StatusOK, `{"id":42,"friendlyName":"api","type":"HTTP","interval":120,"url":"https://api.example","domainExpirationReminder":true,"tags":[{"name":"prod"}]}`), nil
+			case "PATCH /monitors/42":
+			default:
+				t.Fatalf("unexpected request %s", got)
+			}
+			body, _ := io.ReadAll(req.Body)
+			want := `{"friendlyName":"api","url":"https://api.example","type":"HTTP","interval":120,"tagNames":[],"sslExpirationReminder":false,"domainExpirationReminder":true,"followRedirections":false}`
+			if string(body) != want {
+				t.Fatalf("expected update built from the monitor, got %s", body)
+			}
+			return jsonResponse(http.StatusOK, `{"id":42,"tags":[]}`), nil
+		}),
+	}
+	c.SetBaseURL("https://example.test")
+
+	var got []string
+	if _, err := c.UpdateMonitorTags(context.Background(), 42, func(names []string) []string {
+		got = names
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateMonitorTags returned error: %v", err)
+	}
+	if len(got) != 1 || got[0] != "prod" {
+		t.Fatalf("expected edit to get the current tag names, got %v", got)
+	}
+}
@@ -0,0 +1,207 @@
+// Package export implements the provider binary's `export` subcommand, which
+// reads an existing UptimeRobot account and writes Terraform configuration and
+// import blocks for it.
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+// Resource kinds that can be exported, in the order they are written.
+const (
+	KindAlertContact      = "alert_contact"
+	KindIntegration       = "integration"
+	KindMonitorGroup      = "monitor_group"
+	KindMaintenanceWindow = "maintenance_window"
+	KindMonitor           = "monitor"
+	KindPSP               = "psp"
+)
+
+// AllKinds returns every exportable resource kind in output order.
+func AllKinds() []string {
+	return []string{
+		KindAlertContact,
+		KindIntegration,
+		KindMonitorGroup,
+		KindMaintenanceWindow,
+		KindMonitor,
+		KindPSP,
+	}
+}
+
+// Account holds every object read from the API for an export.
+type Account struct {
+	AlertContacts      []client.UserAlertContact
+	Integrations       []client.Integration
+	MonitorGroups      []client.MonitorGroup
+	MaintenanceWindows []client.MaintenanceWindow
+	Monitors           []client.Monitor
+	PSPs               []client.PSP
+}
+
+// Fetch pages through every object of the requested kinds.
+func Fetch(ctx context.Context, c *client.Client, kinds map[string]bool) (*Account, error) {
+	var (
+		account Account
+		err     error
+	)
+
+	if kinds[KindAlertContact] {
+		if account.AlertContacts, err = c.ListAlertContacts(ctx); err != nil {
+			return nil, fmt.Errorf("listing alert contacts: %w", err)
+		}
+	}
+	if kinds[KindIntegration] {
+		if account.Integrations, err = c.ListAllIntegrations(ctx); err != nil {
+			return nil, fmt.Errorf("listing integrations: %w", err)
+		}
+	}
+	if kinds[KindMonitorGroup] {
+		if account.MonitorGroups, err = c.ListAllMonitorGroups(ctx); err != nil {
+			return nil, fmt.Errorf("listing monitor groups: %w", err)
+		}
+	}
+	if kinds[KindMaintenanceWindow] {
+		if account.MaintenanceWindows, err = c.ListAllMaintenanceWindows(ctx); err != nil {
+			return nil, fmt.Errorf("listing maintenance windows: %w", err)
+		}
+	}
+	if kinds[KindMonitor] {
+		if account.Monitors, err = c.GetMonitors(ctx); err != nil {
+			return nil, fmt.Errorf("listing monitors: %w", err)
+		}
+	}
+	if kinds[KindPSP] {
+		if account.PSPs, err = c.ListAllPSPs(ctx); err != nil {
+			return nil, fmt.Errorf("listing PSPs: %w", err)
+		}
+	}
+
+	account.sort()
+	return &account, nil
+}
+
+// sort orders every slice by ID so the generated names and output are stable.
+func (a *Account) sort() {
+	sort.Slice(a.AlertContacts, func(i, j int) bool { return a.AlertContacts[i].ID < a.AlertContacts[j].ID })
+	sort.Slice(a.Integrations, func(i, j int) bool { return a.Integrations[i].ID < a.Integrations[j].ID })
+	sort.Slice(a.MonitorGroups, func(i, j int) bool { return a.MonitorGroups[i].ID < a.MonitorGroups[j].ID })
+	sort.Slice(a.MaintenanceWindows, func(i, j int) bool { return a.MaintenanceWindows[i].ID < a.MaintenanceWindows[j].ID })
+	sort.Slice(a.Monitors, func(i, j int) bool { return a.Monitors[i].ID < a.Monitors[j].ID })
+	sort.Slice(a.PSPs, func(i, j int) bool { return a.PSPs[i].ID < a.PSPs[j].ID })
+}
+
+// ParseKinds parses a comma-separated list of resource kinds. An empty list selects every kind.
+func ParseKinds(value string) (map[string]bool, error) {
+	kinds := make(map[string]bool)
+	if strings.TrimSpace(value) == "" {
+		for _, kind := range AllKinds() {
+			kinds[kind] = true
+		}
+		return kinds, nil
+	}
+
+	known := make(map[string]bool)
+	for _, kind := range AllKinds() {
+		known[kind] = true
+	}
+	for _, raw := range strings.Split(value, ",") {
+		kind := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(raw)), "uptimerobot_")
+		if kind == "" {
+			continue
+		}
+		if !known[kind] {
+			return nil, fmt.Errorf("unknown resource kind %q; expected one of %s", raw, strings.Join(AllKinds(), ", "))
+		}
+		kinds[kind] = true
+	}
+	if len(kinds) == 0 {
+		return nil, errors.New("no resource kinds selected")
+	}
+	return kinds, nil
+}
+
+// Run executes the export subcommand with the arguments that follow "export".
+// It returns the process exit code.
+func Run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: terraform-provider-uptimerobot export [flags]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Writes Terraform configuration and import blocks for every object in an UptimeRobot account.")
+		fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	var (
+		apiKey    string
+		apiURL    string
+		output    string
+		resources string
+	)
+	flags.StringVar(&apiKey, "api-key", os.Getenv("UPTIMEROBOT_API_KEY"), "API key. Defaults to the UPTIMEROBOT_API_KEY environment variable.")
+	flags.StringVar(&apiURL, "api-url", os.Getenv("UPTIMEROBOT_API_URL"), "API endpoint URL. Defaults to the UPTIMEROBOT_API_URL environment variable or the public v3 endpoint.")
+	flags.StringVar(&output, "out", "-", "File to write the configuration to, or - for standard output.")
+	flags.StringVar(&resources, "resources", "", "Comma-separated resource kinds to export ("+strings.Join(AllKinds(), ", ")+"). Defaults to all.")
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	kinds, err := ParseKinds(resources)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 2
+	}
+	if apiKey == "" {
+		fmt.Fprintln(stderr, "Error: the API key was not found in the -api-key flag or the UPTIMEROBOT_API_KEY environment variable.")
+		return 2
+	}
+
+	c := client.NewClient(apiKey)
+	c.SetUserAgent("terraform-provider-uptimerobot-export")
+	if apiURL == "" {
+		apiURL = "https://api.uptimerobot.com/v3"
+	}
+	c.SetBaseURL(apiURL)
+
+	account, err := Fetch(ctx, c, kinds)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 1
+	}
+
+	config, err := Render(account, kinds)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 1
+	}
+
+	if output == "-" || output == "" {
+		if _, err := stdout.Write(config); err != nil {
+			fmt.Fprintf(stderr, "Error: writing configuration: %s\n", err)
+			return 1
+		}
+	} else if err := os.WriteFile(output, config, 0o644); err != nil {
+		fmt.Fprintf(stderr, "Error: writing configuration: %s\n", err)
+		return 1
+	}
+
+	fmt.Fprintf(stderr, "Exported %d alert contacts, %d integrations, %d monitor groups, %d maintenance windows, %d monitors and %d PSPs.\n",
+		len(account.AlertContacts), len(account.Integrations), len(account.MonitorGroups),
+		len(account.MaintenanceWindows), len(account.Monitors), len(account.PSPs))
+	return 0
+}
@@ -0,0 +1,208 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+func newExportTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	responses := map[string]string{
+		"/user/alert-contacts": `[
+			{"id": 11, "friendlyName": "Ops Email", "type": "Email", "value": "ops@example.com", "enableNotificationsFor": "Down", "status": "Active"},
+			{"id": 12, "friendlyName": "Phone", "type": "MobileAppIOS", "status": "Active"}
+		]`,
+		"/integrations": `{"data": [
+			{"id": 21, "friendlyName": "Ops Slack", "type": "Slack", "value": "https://hooks.slack.com/services/x", "customValue": "#ops", "enableNotificationsFor": "UpAndDown", "sslExpirationReminder": true},
+			{"id": 22, "friendlyName": "On-call", "type": "PagerDuty", "enableNotificationsFor": "Down", "location": "eu", "autoResolve": true}
+		], "nextLink": null}`,
+		"/monitor-groups": `{"data": [{"id": 31, "name": "Production"}], "nextLink": null}`,
+		"/maintenance-windows": `{"data": [
+			{"id": 41, "name": "Weekly patching", "interval": "weekly", "time": "02:00:00", "duration": 60, "days": [7, 3]}
+		], "nextLink": null}`,
+		"/monitors": `{"data": [
+			{"id": 51, "friendlyName": "API Health", "type": "HTTP", "url": "https://api.example.com/health", "interval": 300, "timeout": 30,
+			 "httpMethodType": "get", "authType": "NONE", "groupId": 31, "tags": [{"id": 1, "name": "Prod"}],
+			 "maintenanceWindows": [{"id": 41}], "customHttpHeaders": {"X-Token": "abc"},
+			 "assignedAlertContacts": [{"alertContactId": "21", "threshold": 0, "recurrence": 0}, {"alertContactId": 11, "threshold": 1, "recurrence": 5}, {"alertContactId": "99", "threshold": 0, "recurrence": 0}]},
+			{"id": 52, "friendlyName": "API Health", "type": "HEARTBEAT", "url": "https://heartbeat.uptimerobot.com/x", "interval": 300, "gracePeriod": 60},
+			{"id": 53, "friendlyName": "example.com DNS", "type": "DNS", "url": "example.com", "interval": 300, "config": {"dnsRecords": {"A": ["192.0.2.1"]}}}
+		], "nextLink": null}`,
+		"/psps": `{"data": [
+			{"id": 61, "friendlyName": "Status", "monitorIds": [53, 51, 404], "isPasswordSet": true, "status": "ENABLED"}
+		], "nextLink": null}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestExportRendersResourcesImportsAndReferences(t *testing.T) {
+	t.Parallel()
+
+	server := newExportTestServer(t)
+	c := client.NewClient("test-api-key")
+	c.SetBaseURL(server.URL)
+
+	kinds, err := ParseKinds("")
+	if err != nil {
+		t.Fatalf("ParseKinds: %v", err)
+	}
+	account, err := Fetch(context.Background(), c, kinds)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	out, err := Render(account, kinds)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	config := collapseSpaces(string(out))
+
+	if _, diags := hclwrite.ParseConfig(out, "export.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("generated configuration does not parse: %s\n%s", diags.Error(), config)
+	}
+	if formatted := hclwrite.Format(out); !bytes.Equal(formatted, out) {
+		t.Fatalf("generated configuration is not formatted:\n%s", config)
+	}
+
+	for _, want := range []string{
+		`resource "uptimerobot_alert_contact" "ops_email" {`,
+		`notification_events = "down"`,
+		`resource "uptimerobot_integration" "ops_slack" {`,
+		`custom_value = "#ops"`,
+		`value = var.on_call_value`,
+		`resource "uptimerobot_monitor_group" "production" {`,
+		`resource "uptimerobot_maintenance_window" "weekly_patching" {`,
+		`days = [3, 7]`,
+		`resource "uptimerobot_monitor" "api_health" {`,
+		`resource "uptimerobot_monitor" "api_health_52" {`,
+		`group_id = tonumber(uptimerobot_monitor_group.production.id)`,
+		`maintenance_window_ids = [tonumber(uptimerobot_maintenance_window.weekly_patching.id)]`,
+		`alert_contact_id = uptimerobot_alert_contact.ops_email.id`,
+		`alert_contact_id = uptimerobot_integration.ops_slack.id`,
+		`alert_contact_id = "99"`,
+		`tags = ["prod"]`,
+		`x-token = "abc"`,
+		`auth_type = "NONE"`,
+		`monitor_ids = [tonumber(uptimerobot_monitor.api_health.id), tonumber(uptimerobot_monitor.example_com_dns.id), 404]`,
+		`password = var.status_password`,
+		`variable "status_password" {`,
+		`to = uptimerobot_monitor.api_health_52`,
+		`id = "52"`,
+		`# uptimerobot_alert_contact 12 ("Phone")`,
+	} {
+		if !strings.Contains(config, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+	if strings.Contains(config, "heartbeat.uptimerobot.com") {
+		t.Error("expected heartbeat URL to be omitted")
+	}
+	if t.Failed() {
+		t.Logf("generated configuration:\n%s", out)
+	}
+}
+
+func TestExportKeepsLiteralIDsForKindsNotExported(t *testing.T) {
+	t.Parallel()
+
+	server := newExportTestServer(t)
+	c := client.NewClient("test-api-key")
+	c.SetBaseURL(server.URL)
+
+	kinds, err := ParseKinds("uptimerobot_monitor")
+	if err != nil {
+		t.Fatalf("ParseKinds: %v", err)
+	}
+	account, err := Fetch(context.Background(), c, kinds)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	out, err := Render(account, kinds)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	config := collapseSpaces(string(out))
+
+	if strings.Contains(config, "uptimerobot_monitor_group.") || strings.Contains(config, "uptimerobot_psp") {
+		t.Fatalf("expected only monitors to be exported:\n%s", config)
+	}
+	for _, want := range []string{`group_id = 31`, `alert_contact_id = "21"`, `maintenance_window_ids = [41]`} {
+		if !strings.Contains(config, want) {
+			t.Errorf("expected output to contain %q\n%s", want, config)
+		}
+	}
+}
+
+func TestRunWritesOutputFile(t *testing.T) {
+	t.Parallel()
+
+	server := newExportTestServer(t)
+	out := filepath.Join(t.TempDir(), "uptimerobot.tf")
+
+	var stdout, stderr bytes.Buffer
+	code := Run(context.Background(), []string{"-api-key", "test-api-key", "-api-url", server.URL, "-out", out, "-resources", "monitor_group"}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	written, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("reading output: %v", err)
+	}
+	if !strings.Contains(string(written), `resource "uptimerobot_monitor_group" "production"`) {
+		t.Fatalf("unexpected output:\n%s", written)
+	}
+	if !strings.Contains(stderr.String(), "1 monitor groups") {
+		t.Fatalf("expected summary on stderr, got %q", stderr.String())
+	}
+
+	code = Run(context.Background(), []string{"-api-key", "k", "-resources", "tags"}, &stdout, &stderr)
+	if code != 2 {
+		t.Fatalf("expected exit code 2 for an unknown kind, got %d", code)
+	}
+}
+
+// collapseSpaces makes assertions independent of attribute alignment.
+func collapseSpaces(s string) string {
+	return regexp.MustCompile(` {2,}`).ReplaceAllString(s, " ")
+}
+
+func TestResourceName(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		label string
+		want  string
+	}{
+		{label: "API Health (prod)", want: "api_health_prod"},
+		{label: "  --Ops & On-call--  ", want: "ops_on_call"},
+		{label: "123 main", want: "monitor_123_main"},
+		{label: "サイト", want: "monitor_7"},
+	} {
+		if got := resourceName(KindMonitor, 7, tt.label); got != tt.want {
+			t.Errorf("resourceName(%q) = %q, want %q", tt.label, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,697 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/integration"
+)
+
+// Render writes Terraform configuration for the account. Each resource block is
+// followed by an import block, and IDs that point at other exported objects are
+// written as references to those resources. References to kinds that were not
+// exported stay literal IDs.
+func Render(account *Account, kinds map[string]bool) ([]byte, error) {
+	r := newRenderer(kinds)
+	r.assignNames(account)
+
+	for _, contact := range account.AlertContacts {
+		r.renderAlertContact(contact)
+	}
+	for _, item := range account.Integrations {
+		r.renderIntegration(item)
+	}
+	for _, group := range account.MonitorGroups {
+		r.renderMonitorGroup(group)
+	}
+	for _, window := range account.MaintenanceWindows {
+		r.renderMaintenanceWindow(window)
+	}
+	for _, monitor := range account.Monitors {
+		if err := r.renderMonitor(monitor); err != nil {
+			return nil, err
+		}
+	}
+	for _, psp := range account.PSPs {
+		r.renderPSP(psp)
+	}
+
+	return r.bytes(), nil
+}
+
+type renderer struct {
+	kinds     map[string]bool
+	names     map[string]map[int64]string
+	used      map[string]map[string]bool
+	variables *hclwrite.File
+	resources *hclwrite.File
+	skipped   []string
+}
+
+func newRenderer(kinds map[string]bool) *renderer {
+	return &renderer{
+		kinds:     kinds,
+		names:     make(map[string]map[int64]string),
+		used:      make(map[string]map[string]bool),
+		variables: hclwrite.NewEmptyFile(),
+		resources: hclwrite.NewEmptyFile(),
+	}
+}
+
+func (r *renderer) bytes() []byte {
+	var out []byte
+	out = append(out, "# Generated by terraform-provider-uptimerobot export.\n"...)
+	out = append(out, "# Review the configuration, then run terraform plan to import the existing objects.\n"...)
+	if len(r.skipped) > 0 {
+		out = append(out, "#\n# The following objects were not exported:\n"...)
+		for _, line := range r.skipped {
+			out = append(out, "#   "+line+"\n"...)
+		}
+	}
+	if vars := r.variables.Bytes(); len(vars) > 0 {
+		out = append(out, vars...)
+	}
+	out = append(out, r.resources.Bytes()...)
+	return hclwrite.Format(out)
+}
+
+func resourceType(kind string) string {
+	return "uptimerobot_" + kind
+}
+
+// assignNames picks a resource name for every exported object before any block is
+// written, so references resolve regardless of output order.
+func (r *renderer) assignNames(account *Account) {
+	for _, contact := range account.AlertContacts {
+		if alertContactExportable(contact) {
+			r.assignName(KindAlertContact, contact.ID, contact.Name)
+		}
+	}
+	for _, item := range account.Integrations {
+		r.assignName(KindIntegration, item.ID, item.Name)
+	}
+	for _, group := range account.MonitorGroups {
+		if group.ID > 0 {
+			r.assignName(KindMonitorGroup, group.ID, group.Name)
+		}
+	}
+	for _, window := range account.MaintenanceWindows {
+		r.assignName(KindMaintenanceWindow, window.ID, window.Name)
+	}
+	for _, monitor := range account.Monitors {
+		r.assignName(KindMonitor, monitor.ID, monitor.Name)
+	}
+	for _, psp := range account.PSPs {
+		r.assignName(KindPSP, psp.ID, psp.Name)
+	}
+}
+
+func (r *renderer) assignName(kind string, id int64, label string) {
+	if r.names[kind] == nil {
+		r.names[kind] = make(map[int64]string)
+		r.used[kind] = make(map[string]bool)
+	}
+	name := resourceName(kind, id, label)
+	if r.used[kind][name] {
+		name = name + "_" + strconv.FormatInt(id, 10)
+	}
+	r.used[kind][name] = true
+	r.names[kind][id] = name
+}
+
+// resourceName turns a friendly name into a Terraform identifier.
+func resourceName(kind string, id int64, label string) string {
+	var b strings.Builder
+	underscore := false
+	for _, ch := range strings.ToLower(label) {
+		if (ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9') {
+			b.WriteRune(ch)
+			underscore = false
+			continue
+		}
+		if !underscore && b.Len() > 0 {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+	name := strings.TrimRight(b.String(), "_")
+	switch {
+	case name == "":
+		return kind + "_" + strconv.FormatInt(id, 10)
+	case name[0] >= '0' && name[0] <= '9':
+		return kind + "_" + name
+	default:
+		return name
+	}
+}
+
+// beginResource appends a resource block and its import block, returning the resource body.
+func (r *renderer) beginResource(kind string, id int64) *hclwrite.Body {
+	name := r.names[kind][id]
+	body := r.resources.Body()
+
+	body.AppendNewline()
+	block := body.AppendNewBlock("resource", []string{resourceType(kind), name})
+
+	body.AppendNewline()
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType(kind)},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBody.SetAttributeValue("id", cty.StringVal(strconv.FormatInt(id, 10)))
+
+	return block.Body()
+}
+
+// variable declares a sensitive input variable for a secret the API does not return
+// and returns a reference to it.
+func (r *renderer) variable(kind string, id int64, attribute string) hclwrite.Tokens {
+	name := r.names[kind][id] + "_" + attribute
+	body := r.variables.Body()
+	body.AppendNewline()
+	vb := body.AppendNewBlock("variable", []string{name}).Body()
+	vb.SetAttributeValue("description", cty.StringVal(fmt.Sprintf("%s for %s.%s. The API does not return this value.", attribute, resourceType(kind), r.names[kind][id])))
+	vb.SetAttributeRaw("type", hclwrite.TokensForIdentifier("string"))
+	vb.SetAttributeValue("sensitive", cty.True)
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	})
+}
+
+// idReference returns a reference to the id attribute of an exported object.
+func (r *renderer) idReference(kind string, id int64) (hclwrite.Tokens, bool) {
+	name, ok := r.names[kind][id]
+	if !ok || !r.kinds[kind] {
+		return nil, false
+	}
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType(kind)},
+		hcl.TraverseAttr{Name: name},
+		hcl.TraverseAttr{Name: "id"},
+	}), true
+}
+
+// numericReference refers to an exported object from a number attribute. Resource IDs
+// are strings, so references are wrapped in tonumber().
+func (r *renderer) numericReference(kind string, id int64) hclwrite.Tokens {
+	if ref, ok := r.idReference(kind, id); ok {
+		return hclwrite.TokensForFunctionCall("tonumber", ref)
+	}
+	return hclwrite.TokensForValue(cty.NumberIntVal(id))
+}
+
+func (r *renderer) numericReferences(kind string, ids []int64) hclwrite.Tokens {
+	sorted := append([]int64(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	elems := make([]hclwrite.Tokens, 0, len(sorted))
+	for _, id := range sorted {
+		elems = append(elems, r.numericReference(kind, id))
+	}
+	return hclwrite.TokensForTuple(elems)
+}
+
+// alertContactReference refers to a monitor's assigned alert contact, which may be a
+// personal alert contact or an integration.
+func (r *renderer) alertContactReference(rawID string) hclwrite.Tokens {
+	if id, err := strconv.ParseInt(rawID, 10, 64); err == nil {
+		if ref, ok := r.idReference(KindAlertContact, id); ok {
+			return ref
+		}
+		if ref, ok := r.idReference(KindIntegration, id); ok {
+			return ref
+		}
+	}
+	return hclwrite.TokensForValue(cty.StringVal(rawID))
+}
+
+func setString(body *hclwrite.Body, name, value string) {
+	if value != "" {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
+
+func setStringPtr(body *hclwrite.Body, name string, value *string) {
+	if value != nil {
+		setString(body, name, *value)
+	}
+}
+
+func setBoolPtr(body *hclwrite.Body, name string, value *bool) {
+	if value != nil {
+		body.SetAttributeValue(name, cty.BoolVal(*value))
+	}
+}
+
+func setTrue(body *hclwrite.Body, name string, value bool) {
+	if value {
+		body.SetAttributeValue(name, cty.True)
+	}
+}
+
+func setStringMap(body *hclwrite.Body, name string, values map[string]string) {
+	if len(values) == 0 {
+		return
+	}
+	body.SetAttributeValue(name, stringMapValue(values))
+}
+
+func stringMapValue(values map[string]string) cty.Value {
+	m := make(map[string]cty.Value, len(values))
+	for k, v := range values {
+		m[k] = cty.StringVal(v)
+	}
+	return cty.MapVal(m)
+}
+
+func stringListValue(values []string) cty.Value {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	elems := make([]cty.Value, 0, len(sorted))
+	for _, v := range sorted {
+		elems = append(elems, cty.StringVal(v))
+	}
+	if len(elems) == 0 {
+		return cty.ListValEmpty(cty.String)
+	}
+	return cty.TupleVal(elems)
+}
+
+func int64ListValue(values []int64) cty.Value {
+	sorted := append([]int64(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	elems := make([]cty.Value, 0, len(sorted))
+	for _, v := range sorted {
+		elems = append(elems, cty.NumberIntVal(v))
+	}
+	if len(elems) == 0 {
+		return cty.ListValEmpty(cty.Number)
+	}
+	return cty.TupleVal(elems)
+}
+
+func objectAttr(name string, value hclwrite.Tokens) hclwrite.ObjectAttrTokens {
+	return hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(name), Value: value}
+}
+
+func alertContactExportable(contact client.UserAlertContact) bool {
+	return strings.EqualFold(strings.TrimSpace(contact.Type), "email")
+}
+
+func (r *renderer) renderAlertContact(contact client.UserAlertContact) {
+	if !alertContactExportable(contact) {
+		r.skipped = append(r.skipped, fmt.Sprintf("%s %d (%q): %s alert contacts are tied to a device and cannot be recreated from configuration",
+			resourceType(KindAlertContact), contact.ID, contact.Name, contact.Type))
+		return
+	}
+
+	body := r.beginResource(KindAlertContact, contact.ID)
+	body.SetAttributeValue("name", cty.StringVal(contact.Name))
+	body.SetAttributeValue("type", cty.StringVal("email"))
+	if contact.Value != "" {
+		body.SetAttributeValue("value", cty.StringVal(contact.Value))
+	} else {
+		body.SetAttributeRaw("value", r.variable(KindAlertContact, contact.ID, "value"))
+	}
+	if events := alertContactNotificationEvents(contact.EnableNotificationsFor); events != "up_and_down" {
+		body.SetAttributeValue("notification_events", cty.StringVal(events))
+	}
+	setTrue(body, "ssl_expiration_reminder", contact.SSLExpirationReminder)
+}
+
+func alertContactNotificationEvents(value string) string {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "down":
+		return "down"
+	case "up":
+		return "up"
+	case "none":
+		return "none"
+	default:
+		return "up_and_down"
+	}
+}
+
+func integrationNotificationsFor(value string) int64 {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "down":
+		return 2
+	case "up":
+		return 3
+	case "none":
+		return 4
+	default:
+		return 1
+	}
+}
+
+func (r *renderer) renderIntegration(item client.Integration) {
+	integrationType := integration.TransformIntegrationTypeFromAPI(item.Type)
+
+	body := r.beginResource(KindIntegration, item.ID)
+	body.SetAttributeValue("name", cty.StringVal(item.Name))
+	body.SetAttributeValue("type", cty.StringVal(integrationType))
+
+	value := item.WebhookURL
+	if value == "" {
+		value = strings.TrimSpace(item.Value)
+	}
+	if value != "" {
+		body.SetAttributeValue("value", cty.StringVal(value))
+	} else {
+		body.SetAttributeRaw("value", r.variable(KindIntegration, item.ID, "value"))
+	}
+
+	// Webhook settings live in dedicated attributes; customValue holds their JSON encoding.
+	if integrationType != string(integration.IntegrationTypeWebhook) {
+		setString(body, "custom_value", item.CustomValue)
+	}
+	body.SetAttributeValue("enable_notifications_for", cty.NumberIntVal(integrationNotificationsFor(item.EnableNotificationsFor)))
+	body.SetAttributeValue("ssl_expiration_reminder", cty.BoolVal(item.SSLExpirationReminder))
+
+	switch integrationType {
+	case string(integration.IntegrationTypeWebhook):
+		setBoolPtr(body, "send_as_json", item.SendAsJSON)
+		setBoolPtr(body, "send_as_query_string", item.SendAsQueryString)
+		setBoolPtr(body, "send_as_post_parameters", item.SendAsPostParameters)
+		setString(body, "post_value", item.PostValue)
+		setStringMap(body, "custom_headers", item.CustomHeaders)
+	case string(integration.IntegrationTypePushover):
+		setString(body, "priority", item.Priority)
+	case string(integration.IntegrationTypePagerDuty):
+		setString(body, "location", item.Location)
+		setTrue(body, "auto_resolve", item.AutoResolve)
+	}
+}
+
+func (r *renderer) renderMonitorGroup(group client.MonitorGroup) {
+	// Group 0 is the account default group and is not a managed object.
+	if group.ID <= 0 {
+		return
+	}
+	body := r.beginResource(KindMonitorGroup, group.ID)
+	body.SetAttributeValue("name", cty.StringVal(group.Name))
+}
+
+func (r *renderer) renderMaintenanceWindow(window client.MaintenanceWindow) {
+	body := r.beginResource(KindMaintenanceWindow, window.ID)
+	body.SetAttributeValue("name", cty.StringVal(window.Name))
+	body.SetAttributeValue("interval", cty.StringVal(window.Interval))
+	if window.Interval == "once" {
+		setStringPtr(body, "date", window.Date)
+	}
+	body.SetAttributeValue("time", cty.StringVal(window.Time))
+	body.SetAttributeValue("duration", cty.NumberIntVal(int64(window.Duration)))
+	setTrue(body, "auto_add_monitors", window.AutoAddMonitors)
+	if len(window.Days) > 0 && (window.Interval == "weekly" || window.Interval == "monthly") {
+		body.SetAttributeValue("days", int64ListValue(window.Days))
+	}
+	// Monitor assignments are written on the monitors through maintenance_window_ids,
+	// which keeps the dependency graph acyclic.
+}
+
+func monitorTypeIsHTTPLike(monitorType string) bool {
+	switch monitorType {
+	case "HTTP", "KEYWORD", "API":
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *renderer) renderMonitor(m client.Monitor) error {
+	monitorType := strings.ToUpper(m.Type)
+
+	body := r.beginResource(KindMonitor, m.ID)
+	body.SetAttributeValue("name", cty.StringVal(m.Name))
+	body.SetAttributeValue("type", cty.StringVal(monitorType))
+	if monitorType != "HEARTBEAT" {
+		body.SetAttributeValue("url", cty.StringVal(m.URL))
+	}
+	body.SetAttributeValue("interval", cty.NumberIntVal(int64(m.Interval)))
+
+	switch monitorType {
+	case "HEARTBEAT":
+		body.SetAttributeValue("grace_period", cty.NumberIntVal(int64(m.GracePeriod)))
+	case "DNS":
+	default:
+		if m.Timeout > 0 {
+			body.SetAttributeValue("timeout", cty.NumberIntVal(int64(m.Timeout)))
+		}
+	}
+
+	if monitorTypeIsHTTPLike(monitorType) {
+		method := strings.ToUpper(m.HTTPMethodType)
+		setString(body, "http_method_type", method)
+		if len(m.SuccessHTTPResponseCodes) > 0 {
+			body.SetAttributeValue("success_http_response_codes", stringListValue(m.SuccessHTTPResponseCodes))
+		}
+		setTrue(body, "follow_redirections", m.FollowRedirections)
+		setTrue(body, "ssl_expiration_reminder", m.SSLExpirationReminder)
+		setTrue(body, "check_ssl_errors", m.CheckSSLErrors)
+		if method != "GET" && method != "HEAD" {
+			if err := setMonitorBody(body, m); err != nil {
+				return err
+			}
+		}
+	}
+	setTrue(body, "domain_expiration_reminder", m.DomainExpirationReminder)
+
+	if authType := strings.ToUpper(m.AuthType); authType != "" && authType != "HTTP_BASIC" {
+		body.SetAttributeValue("auth_type", cty.StringVal(authType))
+	}
+	setString(body, "http_username", m.HTTPUsername)
+	if m.HTTPUsername != "" || m.HTTPPassword != "" {
+		body.SetAttributeRaw("http_password", r.variable(KindMonitor, m.ID, "http_password"))
+	}
+	if len(m.CustomHTTPHeaders) > 0 {
+		headers := make(map[string]string, len(m.CustomHTTPHeaders))
+		for k, v := range m.CustomHTTPHeaders {
+			headers[strings.ToLower(k)] = v
+		}
+		setStringMap(body, "custom_http_headers", headers)
+	}
+	setStringMap(body, "custom_fields", m.CustomFields)
+
+	if m.Port != nil && (monitorType == "PORT" || monitorType == "UDP") {
+		body.SetAttributeValue("port", cty.NumberIntVal(int64(*m.Port)))
+	}
+
+	if monitorType == "KEYWORD" {
+		setString(body, "keyword_value", m.KeywordValue)
+		setStringPtr(body, "keyword_type", m.KeywordType)
+		if m.KeywordCaseType == 1 {
+			body.SetAttributeValue("keyword_case_type", cty.StringVal("CaseInsensitive"))
+		} else {
+			body.SetAttributeValue("keyword_case_type", cty.StringVal("CaseSensitive"))
+		}
+	}
+
+	if m.ResponseTimeThreshold > 0 {
+		body.SetAttributeValue("response_time_threshold", cty.NumberIntVal(int64(m.ResponseTimeThreshold)))
+	}
+	if m.GroupID > 0 {
+		body.SetAttributeRaw("group_id", r.numericReference(KindMonitorGroup, m.GroupID))
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		ids := make([]int64, 0, len(m.MaintenanceWindows))
+		for _, window := range m.MaintenanceWindows {
+			ids = append(ids, window.ID)
+		}
+		body.SetAttributeRaw("maintenance_window_ids", r.numericReferences(KindMaintenanceWindow, ids))
+	}
+	if len(m.Tags) > 0 {
+		names := make([]string, 0, len(m.Tags))
+		for _, tag := range m.Tags {
+			names = append(names, strings.ToLower(tag.Name))
+		}
+		body.SetAttributeValue("tags", stringListValue(names))
+	}
+	if len(m.AssignedAlertContacts) > 0 {
+		contacts := append([]client.AlertContact(nil), m.AssignedAlertContacts...)
+		sort.Slice(contacts, func(i, j int) bool { return contacts[i].AlertContactID < contacts[j].AlertContactID })
+		elems := make([]hclwrite.Tokens, 0, len(contacts))
+		for _, contact := range contacts {
+			elems = append(elems, hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+				objectAttr("alert_contact_id", r.alertContactReference(string(contact.AlertContactID))),
+				objectAttr("threshold", hclwrite.TokensForValue(cty.NumberIntVal(contact.Threshold))),
+				objectAttr("recurrence", hclwrite.TokensForValue(cty.NumberIntVal(contact.Recurrence))),
+			}))
+		}
+		body.SetAttributeRaw("assigned_alert_contacts", hclwrite.TokensForTuple(elems))
+	}
+
+	if config, ok := monitorConfigValue(monitorType, m.Config); ok {
+		body.SetAttributeValue("config", config)
+	}
+	return nil
+}
+
+// setMonitorBody writes the request body of HTTP-like monitors that send one.
+func setMonitorBody(body *hclwrite.Body, m client.Monitor) error {
+	data := strings.TrimSpace(string(m.PostValueData))
+	if data == "" || data == "null" || m.PostValueType == nil {
+		return nil
+	}
+	switch *m.PostValueType {
+	case "KEY_VALUE":
+		var kv map[string]string
+		if err := json.Unmarshal(m.PostValueData, &kv); err != nil {
+			return fmt.Errorf("monitor %d: decoding key/value body: %w", m.ID, err)
+		}
+		setStringMap(body, "post_value_kv", kv)
+	default:
+		body.SetAttributeValue("post_value_data", cty.StringVal(data))
+	}
+	return nil
+}
+
+func monitorConfigValue(monitorType string, config *client.MonitorConfig) (cty.Value, bool) {
+	attrs := make(map[string]cty.Value)
+	if config != nil {
+		if config.SSLExpirationPeriodDays != nil && monitorTypeIsHTTPLike(monitorType) {
+			attrs["ssl_expiration_period_days"] = int64ListValue(*config.SSLExpirationPeriodDays)
+		}
+		if config.IPVersion != nil && *config.IPVersion != "" {
+			attrs["ip_version"] = cty.StringVal(*config.IPVersion)
+		}
+		if retries, ok := applicationErrorRetries(config.ApplicationErrorRetries); ok && monitorTypeIsHTTPLike(monitorType) {
+			attrs["application_error_retries"] = cty.NumberIntVal(retries)
+		}
+		if monitorType == "DNS" && config.DNSRecords != nil {
+			if records, ok := dnsRecordsValue(config.DNSRecords); ok {
+				attrs["dns_records"] = records
+			}
+		}
+		if monitorType == "API" && config.APIAssertions != nil {
+			attrs["api_assertions"] = apiAssertionsValue(config.APIAssertions)
+		}
+		if monitorType == "UDP" && config.UDP != nil {
+			udp := make(map[string]cty.Value)
+			if config.UDP.Payload != nil {
+				udp["payload"] = cty.StringVal(*config.UDP.Payload)
+			}
+			if config.UDP.PacketLossThreshold != nil {
+				udp["packet_loss_threshold"] = cty.NumberIntVal(*config.UDP.PacketLossThreshold)
+			}
+			if len(udp) > 0 {
+				attrs["udp"] = cty.ObjectVal(udp)
+			}
+		}
+	}
+
+	// DNS monitors require a config block on create.
+	if len(attrs) == 0 && monitorType != "DNS" {
+		return cty.NilVal, false
+	}
+	if len(attrs) == 0 {
+		return cty.EmptyObjectVal, true
+	}
+	return cty.ObjectVal(attrs), true
+}
+
+func applicationErrorRetries(raw json.RawMessage) (int64, bool) {
+	var retries int64
+	if len(raw) == 0 || json.Unmarshal(raw, &retries) != nil {
+		return 0, false
+	}
+	return retries, true
+}
+
+func dnsRecordsValue(records *client.DNSRecords) (cty.Value, bool) {
+	attrs := make(map[string]cty.Value)
+	for name, values := range map[string]*[]string{
+		"a":      records.A,
+		"aaaa":   records.AAAA,
+		"cname":  records.CNAME,
+		"mx":     records.MX,
+		"ns":     records.NS,
+		"txt":    records.TXT,
+		"srv":    records.SRV,
+		"ptr":    records.PTR,
+		"soa":    records.SOA,
+		"spf":    records.SPF,
+		"dnskey": records.DNSKEY,
+		"ds":     records.DS,
+		"nsec":   records.NSEC,
+		"nsec3":  records.NSEC3,
+	} {
+		if values != nil && len(*values) > 0 {
+			attrs[name] = stringListValue(*values)
+		}
+	}
+	if len(attrs) == 0 {
+		return cty.NilVal, false
+	}
+	return cty.ObjectVal(attrs), true
+}
+
+func apiAssertionsValue(assertions *client.APIMonitorAssertions) cty.Value {
+	attrs := make(map[string]cty.Value)
+	if assertions.Logic != "" {
+		attrs["logic"] = cty.StringVal(assertions.Logic)
+	}
+	if len(assertions.Checks) > 0 {
+		checks := make([]cty.Value, 0, len(assertions.Checks))
+		for _, check := range assertions.Checks {
+			checkAttrs := map[string]cty.Value{
+				"property":   cty.StringVal(check.Property),
+				"comparison": cty.StringVal(check.Comparison),
+			}
+			if check.Target != nil {
+				if target, err := json.Marshal(check.Target); err == nil {
+					checkAttrs["target"] = cty.StringVal(string(target))
+				}
+			}
+			checks = append(checks, cty.ObjectVal(checkAttrs))
+		}
+		attrs["checks"] = cty.TupleVal(checks)
+	}
+	return cty.ObjectVal(attrs)
+}
+
+func (r *renderer) renderPSP(psp client.PSP) {
+	body := r.beginResource(KindPSP, psp.ID)
+	body.SetAttributeValue("name", cty.StringVal(psp.Name))
+	setStringPtr(body, "custom_domain", psp.CustomDomain)
+
+	monitorIDs := make([]int64, 0, len(psp.MonitorIDs))
+	autoAdd := false
+	for _, id := range psp.MonitorIDs {
+		if id == 0 {
+			autoAdd = true
+			continue
+		}
+		monitorIDs = append(monitorIDs, id)
+	}
+	if autoAdd {
+		body.SetAttributeValue("auto_add_monitors", cty.True)
+	} else if len(monitorIDs) > 0 {
+		body.SetAttributeRaw("monitor_ids", r.numericReferences(KindMonitor, monitorIDs))
+	}
+	if len(psp.TagIDs) > 0 {
+		body.SetAttributeValue("tag_ids", int64ListValue(psp.TagIDs))
+	}
+	if psp.IsPasswordSet {
+		body.SetAttributeRaw("password", r.variable(KindPSP, psp.ID, "password"))
+	}
+
+	setString(body, "status", psp.Status)
+	setStringPtr(body, "homepage_link", psp.HomepageLink)
+	setStringPtr(body, "ga_code", psp.GACode)
+	body.SetAttributeValue("share_analytics_consent", cty.BoolVal(psp.ShareAnalyticsConsent))
+	body.SetAttributeValue("use_small_cookie_consent_modal", cty.BoolVal(psp.UseSmallCookieConsentModal))
+	body.SetAttributeValue("no_index", cty.BoolVal(psp.NoIndex))
+	body.SetAttributeValue("hide_url_links", cty.BoolVal(psp.HideURLLinks))
+	body.SetAttributeValue("subscription", cty.BoolVal(psp.Subscription))
+	body.SetAttributeValue("show_cookie_bar", cty.BoolVal(psp.ShowCookieBar))
+}
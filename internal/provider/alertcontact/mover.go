@@ -0,0 +1,93 @@
+package alertcontact
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/legacyprovider"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
+)
+
+const alertContactResourceTypeName = "uptimerobot_alert_contact"
+
+// legacyAlertContactModel is the louy/uptimerobot uptimerobot_alert_contact
+// state.
+type legacyAlertContactModel struct {
+	ID           types.String `tfsdk:"id"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	Type         types.String `tfsdk:"type"`
+	Value        types.String `tfsdk:"value"`
+	Status       types.String `tfsdk:"status"`
+}
+
+func legacyAlertContactSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":            schema.StringAttribute{Computed: true},
+			"friendly_name": schema.StringAttribute{Required: true},
+			"type":          schema.StringAttribute{Required: true},
+			"value":         schema.StringAttribute{Required: true},
+			"status":        schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+// MoveState moves uptimerobot_alert_contact resources of the community
+// louy/uptimerobot provider to this resource. Only email contacts are personal
+// alert contacts in API v3; the other v2 types became integrations or were
+// retired.
+func (r *alertContactResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: moveAlertContactFromLegacy},
+	}
+}
+
+func moveAlertContactFromLegacy(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	source := legacyprovider.SourceState(ctx, req, alertContactResourceTypeName, legacyAlertContactSchema(), &resp.Diagnostics)
+	if source == nil {
+		return
+	}
+
+	var legacy legacyAlertContactModel
+	resp.Diagnostics.Append(source.Get(ctx, &legacy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if normalizeAlertContactType(legacy.Type.ValueString()) != "email" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Unsupported Alert Contact Type",
+			fmt.Sprintf(
+				"Only email alert contacts can be moved from %s. Import %q contacts as uptimerobot_integration "+
+					"where UptimeRobot still supports them, and drop the old resource with a removed block.",
+				legacyprovider.Address, legacy.Type.ValueString(),
+			),
+		)
+		return
+	}
+
+	status := normalizeAlertContactStatus(legacy.Status.ValueString())
+	moved := alertContactResourceModel{
+		ID:                      legacy.ID,
+		Name:                    legacy.FriendlyName,
+		Type:                    types.StringValue("email"),
+		Value:                   legacy.Value,
+		NotificationEvents:      types.StringValue("up_and_down"),
+		SSLExpirationReminder:   types.BoolValue(false),
+		IsActive:                alertContactIsActiveState(status, types.BoolNull()),
+		Status:                  stringState(status),
+		OneSignalSubscriptionID: types.StringNull(),
+		OneSignalUserID:         types.StringNull(),
+		DeviceFingerprint:       types.StringNull(),
+		PushToken:               types.StringNull(),
+		AndroidPushUpChannel:    types.StringNull(),
+		AndroidPushDownChannel:  types.StringNull(),
+		Timeouts:                optimeout.Null(),
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, moved)...)
+}
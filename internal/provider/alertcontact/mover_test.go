@@ -0,0 +1,54 @@
+package alertcontact
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/legacyprovider"
+)
+
+func TestMoveAlertContactFromLegacy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	NewResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+
+	move := func(state string) resource.MoveStateResponse {
+		resp := resource.MoveStateResponse{
+			TargetState: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+		}
+		moveAlertContactFromLegacy(ctx, resource.MoveStateRequest{
+			SourceProviderAddress: legacyprovider.Address,
+			SourceTypeName:        alertContactResourceTypeName,
+			SourceRawState:        &tfprotov6.RawState{JSON: []byte(state)},
+		}, &resp)
+		return resp
+	}
+
+	resp := move(`{"id":"7","friendly_name":"Ops","type":"email","value":"ops@example.com","status":"paused"}`)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	var moved alertContactResourceModel
+	if d := resp.TargetState.Get(ctx, &moved); d.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", d)
+	}
+	if moved.ID.ValueString() != "7" || moved.Name.ValueString() != "Ops" || moved.Value.ValueString() != "ops@example.com" {
+		t.Fatalf("unexpected moved state: %+v", moved)
+	}
+	if moved.IsActive.ValueBool() || moved.Status.ValueString() != "paused" {
+		t.Fatalf("expected a paused contact, got is_active=%s status=%s", moved.IsActive, moved.Status)
+	}
+
+	resp = move(`{"id":"8","friendly_name":"Hook","type":"webhook","value":"https://example.com","status":"active"}`)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "uptimerobot_integration") {
+		t.Fatalf("expected webhook contacts to be rejected, got %v", resp.Diagnostics)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
 )
 
@@ -26,8 +29,15 @@ var (
 	_ resource.Resource                = &alertContactResource{}
 	_ resource.ResourceWithConfigure   = &alertContactResource{}
 	_ resource.ResourceWithImportState = &alertContactResource{}
+	_ resource.ResourceWithMoveState   = &alertContactResource{}
 )
 
+// alertContactAPIFieldNames maps alert contact request fields to attribute names.
+var alertContactAPIFieldNames = apidiag.FieldNames{
+	"friendlyName":           "name",
+	"enableNotificationsFor": "notification_events",
+}
+
 // NewResource returns the personal alert contact resource.
 func NewResource() resource.Resource {
 	return &alertContactResource{}
@@ -38,23 +48,28 @@ type alertContactResource struct {
 }
 
 type alertContactResourceModel struct {
-	ID                      types.String `tfsdk:"id"`
-	Name                    types.String `tfsdk:"name"`
-	Type                    types.String `tfsdk:"type"`
-	Value                   types.String `tfsdk:"value"`
-	NotificationEvents      types.String `tfsdk:"notification_events"`
-	SSLExpirationReminder   types.Bool   `tfsdk:"ssl_expiration_reminder"`
-	IsActive                types.Bool   `tfsdk:"is_active"`
-	Status                  types.String `tfsdk:"status"`
-	MobileProviderID        types.Int64  `tfsdk:"mobile_provider_id"`
-	OrgAlertContactID       types.Int64  `tfsdk:"org_alert_contact_id"`
-	OneSignalSubscriptionID types.String `tfsdk:"one_signal_subscription_id"`
-	OneSignalUserID         types.String `tfsdk:"one_signal_user_id"`
-	DeviceFingerprint       types.String `tfsdk:"device_fingerprint"`
-	PushToken               types.String `tfsdk:"push_token"`
-	AndroidPushUpChannel    types.String `tfsdk:"android_push_up_channel"`
-	AndroidPushDownChannel  types.String `tfsdk:"android_push_down_channel"`
-}
+	ID                      types.String   `tfsdk:"id"`
+	Name                    types.String   `tfsdk:"name"`
+	Type                    types.String   `tfsdk:"type"`
+	Value                   types.String   `tfsdk:"value"`
+	NotificationEvents      types.String   `tfsdk:"notification_events"`
+	SSLExpirationReminder   types.Bool     `tfsdk:"ssl_expiration_reminder"`
+	IsActive                types.Bool     `tfsdk:"is_active"`
+	Status                  types.String   `tfsdk:"status"`
+	MobileProviderID        types.Int64    `tfsdk:"mobile_provider_id"`
+	OrgAlertContactID       types.Int64    `tfsdk:"org_alert_contact_id"`
+	OneSignalSubscriptionID types.String   `tfsdk:"one_signal_subscription_id"`
+	OneSignalUserID         types.String   `tfsdk:"one_signal_user_id"`
+	DeviceFingerprint       types.String   `tfsdk:"device_fingerprint"`
+	PushToken               types.String   `tfsdk:"push_token"`
+	AndroidPushUpChannel    types.String   `tfsdk:"android_push_up_channel"`
+	AndroidPushDownChannel  types.String   `tfsdk:"android_push_down_channel"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+// alertContactDeleteTimeout is how long Delete waits for the contact to
+// disappear when the timeouts block does not override it.
+const alertContactDeleteTimeout = 2 * time.Minute
 
 func (r *alertContactResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.client = providerclient.FromResourceConfigure(req, resp)
@@ -194,6 +209,9 @@ func (r *alertContactResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			optimeout.BlockName: optimeout.Block(),
+		},
 	}
 }
 
@@ -213,10 +231,18 @@ func (r *alertContactResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := optimeout.Create(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := createTimeout.Context(ctx)
+	defer cancel()
+
 	createReq := buildCreateAlertContactRequest(plan)
 	contact, err := r.client.CreateAlertContact(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating alert contact", "Could not create alert contact, unexpected error: "+err.Error())
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, alertContactAPIFieldNames, "Error creating alert contact", "Could not create alert contact, unexpected error: "+err.Error(), err)
 		return
 	}
 
@@ -249,6 +275,14 @@ func (r *alertContactResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	readTimeout, diags := optimeout.Read(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := readTimeout.Context(ctx)
+	defer cancel()
+
 	contact, err := r.client.GetAlertContact(ctx, id)
 	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
@@ -284,9 +318,17 @@ func (r *alertContactResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := optimeout.Update(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := updateTimeout.Context(ctx)
+	defer cancel()
+
 	contact, err := r.client.UpdateAlertContact(ctx, id, buildUpdateAlertContactRequest(plan, config))
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating alert contact", "Could not update alert contact, unexpected error: "+err.Error())
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, alertContactAPIFieldNames, "Error updating alert contact", "Could not update alert contact, unexpected error: "+err.Error(), err)
 		return
 	}
 
@@ -311,11 +353,19 @@ func (r *alertContactResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := optimeout.Delete(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := deleteTimeout.Context(ctx)
+	defer cancel()
+
 	if err := r.client.DeleteAlertContact(ctx, id); err != nil {
-		resp.Diagnostics.AddError("Error deleting alert contact", "Could not delete alert contact, unexpected error: "+err.Error())
+		apidiag.AddRequestError(&resp.Diagnostics, "Error deleting alert contact", "Could not delete alert contact, unexpected error: "+err.Error(), err)
 		return
 	}
-	if err := r.client.WaitAlertContactDeleted(ctx, id, 2*time.Minute); err != nil {
+	if err := r.client.WaitAlertContactDeleted(ctx, id, deleteTimeout.Wait(alertContactDeleteTimeout)); err != nil {
 		resp.Diagnostics.AddError("Timed out waiting for deletion", err.Error())
 	}
 }
@@ -327,7 +377,11 @@ func (r *alertContactResource) ImportState(ctx context.Context, req resource.Imp
 func rollbackAlertContactAfterCreateUpdateFailure(ctx context.Context, apiClient *client.Client, id int64, updateErr error, diags interface {
 	AddError(string, string)
 }) {
-	rollbackErr := apiClient.DeleteAlertContact(ctx, id)
+	// The create timeout may already have expired; the rollback still needs to run.
+	rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 45*time.Second)
+	defer cancel()
+
+	rollbackErr := apiClient.DeleteAlertContact(rollbackCtx, id)
 	if rollbackErr != nil {
 		diags.AddError(
 			"Error updating alert contact after create",
@@ -336,7 +390,7 @@ func rollbackAlertContactAfterCreateUpdateFailure(ctx context.Context, apiClient
 		return
 	}
 
-	if waitErr := apiClient.WaitAlertContactDeleted(ctx, id, 30*time.Second); waitErr != nil {
+	if waitErr := apiClient.WaitAlertContactDeleted(rollbackCtx, id, 30*time.Second); waitErr != nil {
 		diags.AddError(
 			"Error updating alert contact after create",
 			fmt.Sprintf("The alert contact was created (id=%d), update failed, rollback delete was requested, but deletion was not confirmed: update=%v rollback_wait=%v", id, updateErr, waitErr),
@@ -477,6 +531,7 @@ func alertContactResourceState(contact client.UserAlertContact, prev alertContac
 		PushToken:               types.StringNull(),
 		AndroidPushUpChannel:    types.StringNull(),
 		AndroidPushDownChannel:  types.StringNull(),
+		Timeouts:                prev.Timeouts,
 	}
 
 	switch {
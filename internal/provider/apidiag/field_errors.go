@@ -0,0 +1,154 @@
+package apidiag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+// Schema is the part of a resource schema needed to resolve attribute paths.
+// tfsdk.Plan.Schema and tfsdk.State.Schema satisfy it.
+type Schema interface {
+	TypeAtPath(context.Context, path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// FieldNames maps API field names that are not the camelCase form of their
+// attribute name, e.g. "friendlyName" to "name". Mapping a field to "" drops that
+// segment, which is useful for request envelopes such as "data".
+type FieldNames map[string]string
+
+// AddError adds an API error to diags. Field-level validation errors the API
+// returned are attached to the matching attribute so Terraform highlights it;
+// anything that does not resolve to an attribute is reported as a single error
+// with the given summary and detail.
+func AddError(ctx context.Context, diags *diag.Diagnostics, s Schema, names FieldNames, summary, detail string, err error) {
+	if errors.Is(err, client.ErrReadOnly) {
+		AddReadOnlyError(diags, err)
+		return
+	}
+	apiErr, ok := client.AsAPIError(err)
+	if !ok || len(apiErr.FieldErrors) == 0 || s == nil {
+		diags.AddError(summary, detail)
+		return
+	}
+
+	unresolved := false
+	for _, fe := range apiErr.FieldErrors {
+		p, ok := AttributePath(ctx, s, names, fe.Field)
+		if !ok {
+			unresolved = true
+			continue
+		}
+		diags.AddAttributeError(p, summary, fmt.Sprintf("The API rejected %q: %s", fe.Field, fe.Message))
+	}
+	if unresolved {
+		diags.AddError(summary, detail)
+	}
+}
+
+// AddRequestError adds a failed API request to diags. A write the client
+// refused in read-only mode is reported with AddReadOnlyError, so every write
+// path explains read-only mode the same way; any other error is reported
+// with the given summary and detail.
+func AddRequestError(diags *diag.Diagnostics, summary, detail string, err error) {
+	if errors.Is(err, client.ErrReadOnly) {
+		AddReadOnlyError(diags, err)
+		return
+	}
+	diags.AddError(summary, detail)
+}
+
+// AddAttributeRequestError is AddRequestError for a request about the
+// attribute at p.
+func AddAttributeRequestError(diags *diag.Diagnostics, p path.Path, summary, detail string, err error) {
+	if errors.Is(err, client.ErrReadOnly) {
+		AddReadOnlyError(diags, err)
+		return
+	}
+	diags.AddAttributeError(p, summary, detail)
+}
+
+// AddReadOnlyError reports a write the client refused because the provider is
+// in read-only mode.
+func AddReadOnlyError(diags *diag.Diagnostics, err error) {
+	diags.AddError(
+		"Provider In Read-Only Mode",
+		"The provider is configured with read_only = true or UPTIMEROBOT_READ_ONLY, so it does not change anything in UptimeRobot. "+
+			"Plan and refresh work in this mode; unset it to apply.\n\n"+err.Error(),
+	)
+}
+
+// AttributePath resolves an API field path such as "assignedAlertContacts[2].threshold"
+// to the deepest schema path it names. Indexes into sets and maps cannot be
+// addressed, so the path stops at the collection attribute.
+func AttributePath(ctx context.Context, s Schema, names FieldNames, field string) (path.Path, bool) {
+	p := path.Empty()
+	var current attr.Type
+	found := false
+
+	for _, segment := range splitFieldPath(field) {
+		if index, err := strconv.Atoi(segment); err == nil {
+			listType, ok := current.(types.ListType)
+			if !ok {
+				break
+			}
+			p = p.AtListIndex(index)
+			current = listType.ElemType
+			continue
+		}
+
+		name, mapped := names[segment]
+		if !mapped {
+			name = snakeCase(segment)
+		}
+		if name == "" {
+			continue
+		}
+
+		next := p.AtName(name)
+		typ, diags := s.TypeAtPath(ctx, next)
+		if diags.HasError() {
+			break
+		}
+		p, current, found = next, typ, true
+	}
+
+	return p, found
+}
+
+// splitFieldPath splits "a.b[2].c" into "a", "b", "2", "c".
+func splitFieldPath(field string) []string {
+	return strings.FieldsFunc(field, func(r rune) bool {
+		return r == '.' || r == '[' || r == ']'
+	})
+}
+
+// snakeCase converts an API field name to its attribute name:
+// "sslExpirationPeriodDays" becomes "ssl_expiration_period_days" and
+// "checkSSLErrors" becomes "check_ssl_errors".
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			nextLower := i > 0 && i+1 < len(runes) && unicode.IsUpper(runes[i-1]) && unicode.IsLower(runes[i+1])
+			if prevLower || nextLower {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,152 @@
+package apidiag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+func testSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":             schema.StringAttribute{Optional: true},
+			"check_ssl_errors": schema.BoolAttribute{Optional: true},
+			"custom_fields":    schema.MapAttribute{Optional: true, ElementType: types.StringType},
+			"assigned_alert_contacts": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"threshold": schema.Int64Attribute{Optional: true},
+					},
+				},
+			},
+			"config": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"checks": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"port": schema.Int64Attribute{Optional: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestAttributePath(t *testing.T) {
+	t.Parallel()
+
+	names := FieldNames{"friendlyName": "name", "data": ""}
+	tests := []struct {
+		field  string
+		want   path.Path
+		wantOK bool
+	}{
+		{field: "friendlyName", want: path.Root("name"), wantOK: true},
+		{field: "data.friendlyName", want: path.Root("name"), wantOK: true},
+		{field: "checkSSLErrors", want: path.Root("check_ssl_errors"), wantOK: true},
+		{field: "assignedAlertContacts[2].threshold", want: path.Root("assigned_alert_contacts"), wantOK: true},
+		{field: "config.checks[1].port", want: path.Root("config").AtName("checks").AtListIndex(1).AtName("port"), wantOK: true},
+		{field: "config.unknownField", want: path.Root("config"), wantOK: true},
+		{field: "customFields.env", want: path.Root("custom_fields"), wantOK: true},
+		{field: "somethingElse"},
+	}
+
+	s := testSchema()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.field, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := AttributePath(context.Background(), s, names, tt.field)
+			if ok != tt.wantOK {
+				t.Fatalf("expected ok=%t, got %t (%s)", tt.wantOK, ok, got)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Fatalf("expected path %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAddError(t *testing.T) {
+	t.Parallel()
+
+	s := testSchema()
+	names := FieldNames{"friendlyName": "name"}
+
+	var diags diag.Diagnostics
+	err := &client.APIError{StatusCode: 400, Message: "Validation failed", FieldErrors: []client.FieldError{
+		{Field: "friendlyName", Message: "must not be empty"},
+	}}
+	AddError(context.Background(), &diags, s, names, "Error creating", "detail", err)
+	if len(diags) != 1 {
+		t.Fatalf("expected a single attribute diagnostic, got %v", diags)
+	}
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("name")) {
+		t.Fatalf("expected diagnostic on name, got %#v", diags[0])
+	}
+	if !strings.Contains(diags[0].Detail(), "must not be empty") {
+		t.Fatalf("expected API message in detail, got %q", diags[0].Detail())
+	}
+
+	diags = nil
+	err.FieldErrors = append(err.FieldErrors, client.FieldError{Field: "mystery", Message: "is invalid"})
+	AddError(context.Background(), &diags, s, names, "Error creating", "detail", err)
+	if len(diags) != 2 || diags[1].Detail() != "detail" {
+		t.Fatalf("expected the general error for an unresolved field, got %v", diags)
+	}
+
+	diags = nil
+	AddError(context.Background(), &diags, s, names, "Error creating", "detail", errors.New("boom"))
+	if len(diags) != 1 || diags[0].Summary() != "Error creating" {
+		t.Fatalf("expected the general error for a non-API error, got %v", diags)
+	}
+}
+
+func TestAddRequestError(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	err := fmt.Errorf("deleting monitor: %w", client.ErrReadOnly)
+	AddRequestError(&diags, "Error deleting monitor", "detail", err)
+	if len(diags) != 1 || diags[0].Summary() != "Provider In Read-Only Mode" {
+		t.Fatalf("expected the read-only diagnostic, got %v", diags)
+	}
+
+	diags = nil
+	AddAttributeRequestError(&diags, path.Root("name"), "Error deleting monitor", "detail", errors.New("boom"))
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if len(diags) != 1 || !ok || !withPath.Path().Equal(path.Root("name")) || diags[0].Detail() != "detail" {
+		t.Fatalf("expected the attribute error for other errors, got %v", diags)
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]string{
+		"name":                    "name",
+		"sslExpirationPeriodDays": "ssl_expiration_period_days",
+		"checkSSLErrors":          "check_ssl_errors",
+		"URL":                     "url",
+		"responseTimeThreshold":   "response_time_threshold",
+	} {
+		if got := snakeCase(in); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Package deletionprotection implements the deletion_protection attribute
+// shared by monitors, monitor groups, status pages and integrations.
+//
+// A protected object cannot be destroyed: Delete fails before calling the API.
+// To destroy it, deletion_protection has to be set to false in an earlier
+// apply, so that state records the decision. Without the attribute the
+// provider protect_all setting applies.
+package deletionprotection
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AttributeName is the name of the attribute in every protected resource.
+const AttributeName = "deletion_protection"
+
+// Attribute returns the deletion_protection attribute.
+func Attribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "When true, destroying this object fails. Set it to false and apply before destroying. " +
+			"When omitted, the provider `protect_all` setting applies.",
+		Optional: true,
+	}
+}
+
+// Protected reports whether the object with the given state value is
+// protected.
+func Protected(value types.Bool, protectAll bool) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return protectAll
+	}
+	return value.ValueBool()
+}
+
+// CheckDelete adds an error to diags and returns false when the object is
+// protected. kind and id name the object in the diagnostic, e.g. "monitor"
+// and "123".
+func CheckDelete(value types.Bool, protectAll bool, kind, id string, diags *diag.Diagnostics) bool {
+	if !Protected(value, protectAll) {
+		return true
+	}
+	reason := "deletion_protection is true"
+	if value.IsNull() || value.IsUnknown() {
+		reason = "the provider sets protect_all and deletion_protection is not set"
+	}
+	diags.AddError(
+		"Deletion Protection Enabled",
+		fmt.Sprintf(
+			"The %s %s was not destroyed because %s. If destroying it is intended, set deletion_protection = false, "+
+				"apply, and then destroy it. If it was not intended, check for a changed for_each key, count or resource address.",
+			kind, id, reason,
+		),
+	)
+	return false
+}
@@ -0,0 +1,50 @@
+package deletionprotection
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProtected(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		value      types.Bool
+		protectAll bool
+		want       bool
+	}{
+		{"null without protect_all", types.BoolNull(), false, false},
+		{"null with protect_all", types.BoolNull(), true, true},
+		{"unknown with protect_all", types.BoolUnknown(), true, true},
+		{"true", types.BoolValue(true), false, true},
+		{"false overrides protect_all", types.BoolValue(false), true, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Protected(tc.value, tc.protectAll); got != tc.want {
+				t.Fatalf("Protected() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCheckDelete(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	if CheckDelete(types.BoolValue(true), false, "monitor", "123", &diags) {
+		t.Fatal("expected a protected monitor to be blocked")
+	}
+	if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Deletion Protection Enabled" {
+		t.Fatalf("expected a deletion protection error, got %v", diags)
+	}
+
+	diags = nil
+	if !CheckDelete(types.BoolValue(false), true, "monitor", "123", &diags) || diags.HasError() {
+		t.Fatalf("expected an unprotected monitor to be deletable, got %v", diags)
+	}
+}
@@ -0,0 +1,92 @@
+package integration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+// Integrations have no custom fields, so with create_idempotency_tokens the
+// create token is appended to the name of the create request and removed by
+// the update that follows. The token is derived from the create request, so
+// a rerun or another process sending the same create finds an integration
+// whose name still ends with it and adopts it instead of creating a second
+// one.
+
+// integrationCreateToken returns a hash of the create request and the
+// provider ownership stamp, so every process sending the same create from
+// the same workspace derives the same token.
+func integrationCreateToken(integrationType string, data any, stamp *providerclient.OwnershipStamp) (string, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	salt := ""
+	if stamp != nil {
+		salt = stamp.Key + "=" + stamp.Value
+	}
+	sum := sha256.Sum256([]byte(integrationType + "\x00" + salt + "\x00" + string(body)))
+	return hex.EncodeToString(sum[:6]), nil
+}
+
+func isIntegrationConflict(err error) bool {
+	apiErr, ok := client.AsAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusConflict
+}
+
+func integrationCreateTokenSuffix(token string) string {
+	return " [tf-" + token + "]"
+}
+
+// withCreateTokenName returns data with the create token appended to its
+// friendlyName.
+func withCreateTokenName(data any, token string) (map[string]any, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, err
+	}
+	name, _ := out["friendlyName"].(string)
+	out["friendlyName"] = name + integrationCreateTokenSuffix(token)
+	return out, nil
+}
+
+// findIntegrationByCreateToken returns the integration an earlier or failed
+// attempt created with token, or nil when there is none. Several matches are
+// an error.
+func findIntegrationByCreateToken(ctx context.Context, apiClient *client.Client, token string) (*client.Integration, error) {
+	integrations, err := apiClient.ListAllIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	suffix := integrationCreateTokenSuffix(token)
+	var matches []client.Integration
+	for _, integration := range integrations {
+		if strings.HasSuffix(integration.Name, suffix) {
+			matches = append(matches, integration)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, m := range matches {
+			ids = append(ids, strconv.FormatInt(m.ID, 10))
+		}
+		return nil, fmt.Errorf("integrations %s all carry create token %s; delete the extra integrations, or import one of them, and apply again", strings.Join(ids, ", "), token)
+	}
+}
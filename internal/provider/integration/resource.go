@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -25,8 +26,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apiretry"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/deletionprotection"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/maputil"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
 )
 
@@ -513,6 +517,24 @@ var (
 	_ resource.ResourceWithImportState = &integrationResource{}
 )
 
+// integrationAPIFieldNames maps integration request fields to attribute names.
+// The request wraps the settings in "data" and every type names its target
+// field differently, so they all map to value.
+var integrationAPIFieldNames = apidiag.FieldNames{
+	"data":           "",
+	"friendlyName":   "name",
+	"customValue":    "custom_value",
+	"webhookURL":     "value",
+	"webhookUrl":     "value",
+	"roomURL":        "value",
+	"urlToNotify":    "value",
+	"hookURL":        "value",
+	"accessToken":    "value",
+	"userKey":        "value",
+	"integrationKey": "value",
+	"customMessage":  "custom_value",
+}
+
 // NewResource returns the integration resource.
 func NewResource() resource.Resource {
 	return &integrationResource{}
@@ -520,31 +542,66 @@ func NewResource() resource.Resource {
 
 // integrationResource is the resource implementation.
 type integrationResource struct {
-	client *client.Client
+	client       *client.Client
+	protectAll   bool
+	createTokens bool
+	// ownershipStamp keeps the create tokens of workspaces with different
+	// stamps apart; nil when the provider sets none.
+	ownershipStamp *providerclient.OwnershipStamp
 }
 
 // integrationResourceModel maps the resource schema data.
 type integrationResourceModel struct {
-	ID                     types.String `tfsdk:"id"`
-	Name                   types.String `tfsdk:"name"`
-	Type                   types.String `tfsdk:"type"`
-	Value                  types.String `tfsdk:"value"`
-	CustomValue            types.String `tfsdk:"custom_value"`
-	EnableNotificationsFor types.Int64  `tfsdk:"enable_notifications_for"`
-	SSLExpirationReminder  types.Bool   `tfsdk:"ssl_expiration_reminder"`
-	SendAsJSON             types.Bool   `tfsdk:"send_as_json"`
-	SendAsQueryString      types.Bool   `tfsdk:"send_as_query_string"`
-	SendAsPostParameters   types.Bool   `tfsdk:"send_as_post_parameters"`
-	PostValue              types.String `tfsdk:"post_value"`
-	CustomHeaders          types.Map    `tfsdk:"custom_headers"`
-	Priority               types.String `tfsdk:"priority"`
-	Location               types.String `tfsdk:"location"`
-	AutoResolve            types.Bool   `tfsdk:"auto_resolve"`
+	ID                     types.String   `tfsdk:"id"`
+	DeletionProtection     types.Bool     `tfsdk:"deletion_protection"`
+	Name                   types.String   `tfsdk:"name"`
+	Type                   types.String   `tfsdk:"type"`
+	Value                  types.String   `tfsdk:"value"`
+	CustomValue            types.String   `tfsdk:"custom_value"`
+	EnableNotificationsFor types.Int64    `tfsdk:"enable_notifications_for"`
+	SSLExpirationReminder  types.Bool     `tfsdk:"ssl_expiration_reminder"`
+	SendAsJSON             types.Bool     `tfsdk:"send_as_json"`
+	SendAsQueryString      types.Bool     `tfsdk:"send_as_query_string"`
+	SendAsPostParameters   types.Bool     `tfsdk:"send_as_post_parameters"`
+	PostValue              types.String   `tfsdk:"post_value"`
+	CustomHeaders          types.Map      `tfsdk:"custom_headers"`
+	Priority               types.String   `tfsdk:"priority"`
+	Location               types.String   `tfsdk:"location"`
+	AutoResolve            types.Bool     `tfsdk:"auto_resolve"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+
+	// Write-only secret. Terraform keeps it null in plan and state; see
+	// integrationRequestValue.
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+}
+
+// integrationRequestValue returns the value to send to the API: value_wo from
+// configuration when set, otherwise the planned value.
+func integrationRequestValue(plan, config integrationResourceModel) types.String {
+	if !config.ValueWO.IsNull() && !config.ValueWO.IsUnknown() {
+		return config.ValueWO
+	}
+	return plan.Value
 }
 
+// Default waits used when the timeouts block does not override them.
+const (
+	integrationSettleTimeout     = 90 * time.Second
+	integrationReadSettleTimeout = 60 * time.Second
+	integrationDeleteTimeout     = 2 * time.Minute
+)
+
 // Configure adds the provider configured client to the resource.
 func (r *integrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerclient.FromResourceConfigure(req, resp)
+	data := providerclient.DataFromResourceConfigure(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+	r.protectAll = data.ProtectAll
+	r.createTokens = data.CreateTokens
+	r.ownershipStamp = data.OwnershipStamp
 }
 
 // Metadata returns the resource type name.
@@ -557,6 +614,7 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an integration in UptimeRobot.",
 		Attributes: map[string]schema.Attribute{
+			deletionprotection.AttributeName: deletionprotection.Attribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The ID of this integration.",
@@ -576,9 +634,29 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"value": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The value for the integration (e.g. webhook URL).",
+				MarkdownDescription: "The value for the integration (e.g. webhook URL). Exactly one of `value` and `value_wo` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("value_wo")),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				MarkdownDescription: "Write-only variant of `value` for webhook URLs and tokens that must not be stored in state. " +
+					"Requires Terraform 1.11 or later. It is sent on create and on every update; change `value_wo_version` to update the integration when only this value changed.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("value_wo_version")),
+				},
+			},
+			"value_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `value_wo`. Change it to send a new `value_wo` to the API.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
 			},
 			"custom_value": schema.StringAttribute{
 				Optional:            true,
@@ -655,6 +733,9 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			optimeout.BlockName: optimeout.Block(),
+		},
 	}
 }
 
@@ -674,6 +755,18 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// value_wo is sent in place of value but never written to state.
+	storedValue := plan.Value
+	plan.Value = integrationRequestValue(plan, config)
+
+	createTimeout, diags := optimeout.Create(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := createTimeout.Context(ctx)
+	defer cancel()
+
 	// Create new integration with the new API format
 	integrationTypeAPI := TransformIntegrationTypeToAPI(plan.Type.ValueString())
 
@@ -859,26 +952,27 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	defer unlockCreate()
 
-	var newIntegration *client.Integration
-	backoffs := []time.Duration{
-		500 * time.Millisecond, 1 * time.Second, 2 * time.Second, 3 * time.Second, 5 * time.Second,
-	}
-	var err error
-	for i := 0; i < len(backoffs); i++ {
-		newIntegration, err = r.client.CreateIntegration(ctx, integration)
-		if err == nil {
-			break
-		}
-		if !apiretry.IsTempServerErr(err) || i == len(backoffs)-1 {
-			break
+	var token string
+	if r.createTokens {
+		var tokenErr error
+		token, tokenErr = integrationCreateToken(integrationTypeAPI, integrationData, r.ownershipStamp)
+		if tokenErr == nil {
+			integration.Data, tokenErr = withCreateTokenName(integrationData, token)
 		}
-		select {
-		case <-ctx.Done():
-			resp.Diagnostics.AddError("Create cancelled", ctx.Err().Error())
+		if tokenErr != nil {
+			resp.Diagnostics.AddError(
+				"Error creating integration",
+				"Could not add the create token to the request: "+tokenErr.Error(),
+			)
 			return
-		case <-time.After(backoffs[i]):
 		}
 	}
+
+	newIntegration, err := r.createIntegration(ctx, integration, token, &resp.Diagnostics)
+	if err != nil && ctx.Err() != nil {
+		resp.Diagnostics.AddError("Create cancelled", ctx.Err().Error())
+		return
+	}
 	if err != nil {
 		if apiErr, ok := client.AsAPIError(err); ok && apiErr.StatusCode == http.StatusConflict {
 			msg := strings.TrimSpace(apiErr.Message)
@@ -895,9 +989,10 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 			)
 			return
 		}
-		resp.Diagnostics.AddError(
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, integrationAPIFieldNames,
 			"Error creating integration",
 			"Could not create integration, unexpected error: "+err.Error(),
+			err,
 		)
 		return
 	}
@@ -905,7 +1000,8 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	expectedNotifications := convertNotificationsForToString(plan.EnableNotificationsFor.ValueInt64())
 	expectedSSLExpirationReminder := plan.SSLExpirationReminder.ValueBool()
 	settingsCorrected := false
-	if !integrationSettingsMatch(newIntegration, expectedNotifications, expectedSSLExpirationReminder) {
+	// With a create token the update also removes it from the name.
+	if token != "" || !integrationSettingsMatch(newIntegration, expectedNotifications, expectedSSLExpirationReminder) {
 		updated, updateErr := r.updateIntegrationWithRetry(ctx, newIntegration.ID, &client.UpdateIntegrationRequest{
 			Type: integrationTypeAPI,
 			Data: integrationData,
@@ -926,7 +1022,7 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 			customHeaders:          customHeaders,
 			enableNotificationsFor: expectedNotifications,
 			sslExpirationReminder:  &expectedSSLExpirationReminder,
-		}, 90*time.Second)
+		}, createTimeout.Wait(integrationSettleTimeout))
 		if settleErr != nil {
 			rollbackIntegrationAfterCreateFailure(ctx, r.client, newIntegration.ID, settleErr, &resp.Diagnostics)
 			return
@@ -954,6 +1050,7 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Set state to fully populated data
+	plan.Value = storedValue
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -961,6 +1058,66 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 }
 
+// createIntegration creates the integration for req, retrying temporary
+// server errors. With a create token it first adopts an integration that an
+// earlier or failed attempt of this create stored, and looks for the token
+// again when an attempt fails. A failed lookup is never taken for "no
+// integration": before the create it is returned as the error, and after a
+// failed attempt it is added to the create error.
+func (r *integrationResource) createIntegration(ctx context.Context, req *client.CreateIntegrationRequest, token string, diags *diag.Diagnostics) (*client.Integration, error) {
+	if token != "" {
+		found, err := findIntegrationByCreateToken(ctx, r.client, token)
+		if err != nil {
+			return nil, fmt.Errorf("could not look up an integration stored by an earlier attempt of this create, so the integration was not created to avoid a duplicate: %w", err)
+		}
+		if found != nil {
+			addAdoptedIntegrationWarning(diags, found, token)
+			return found, nil
+		}
+	}
+
+	backoffs := []time.Duration{
+		500 * time.Millisecond, 1 * time.Second, 2 * time.Second, 3 * time.Second, 5 * time.Second,
+	}
+	var findErr error
+	for i := 0; ; i++ {
+		created, err := r.client.CreateIntegration(ctx, req)
+		if err == nil {
+			return created, nil
+		}
+		// The attempt may have been stored despite a server error, and a
+		// retry then conflicts with it.
+		if token != "" && (apiretry.IsTempServerErr(err) || isIntegrationConflict(err)) {
+			var found *client.Integration
+			found, findErr = findIntegrationByCreateToken(ctx, r.client, token)
+			if found != nil {
+				addAdoptedIntegrationWarning(diags, found, token)
+				return found, nil
+			}
+		}
+		if !apiretry.IsTempServerErr(err) || i == len(backoffs)-1 {
+			if findErr != nil {
+				// Not wrapped, so Create reports the whole message instead
+				// of only the API's conflict message.
+				err = fmt.Errorf("%v (the integration may have been stored anyway, but looking it up by its create token %s failed: %v; apply again to adopt it)", err, token, findErr)
+			}
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoffs[i]):
+		}
+	}
+}
+
+func addAdoptedIntegrationWarning(diags *diag.Diagnostics, found *client.Integration, token string) {
+	diags.AddWarning(
+		"Adopting integration from an earlier create",
+		fmt.Sprintf("Integration %d carries create token %s, so an earlier or failed attempt of this create stored it without recording it in state; adopting it instead of creating a duplicate.", found.ID, token),
+	)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
@@ -982,6 +1139,14 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := optimeout.Read(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := readTimeout.Context(ctx)
+	defer cancel()
+
 	// integration, err := r.client.GetIntegration(ctx, id)
 	// if client.IsNotFound(err) {
 	// 	resp.State.RemoveResource(ctx)
@@ -1040,7 +1205,7 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 	if !state.Name.IsNull() && !state.Name.IsUnknown() {
 		expectedName := state.Name.ValueString()
 		if expectedName != "" && integration.Name != expectedName {
-			if settled, err := r.waitIntegrationSettled(ctx, id, integrationSettleExpectations{name: expectedName}, 60*time.Second); err == nil && settled != nil {
+			if settled, err := r.waitIntegrationSettled(ctx, id, integrationSettleExpectations{name: expectedName}, readTimeout.Wait(integrationReadSettleTimeout)); err == nil && settled != nil {
 				integration = settled
 			} else if settled != nil {
 				integration = settled
@@ -1053,7 +1218,8 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 	state.Type = types.StringValue(TransformIntegrationTypeFromAPI(integration.Type))
 	intType := TransformIntegrationTypeFromAPI(integration.Type)
 
-	if integrationEchoesValueFromAPI(intType) {
+	// A value managed through value_wo must not be echoed into state.
+	if integrationEchoesValueFromAPI(intType) && state.ValueWOVersion.IsNull() {
 		if integration.WebhookURL != "" {
 			state.Value = types.StringValue(integration.WebhookURL)
 		} else if strings.TrimSpace(integration.Value) != "" {
@@ -1105,7 +1271,7 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 				if settled, err := r.waitIntegrationSettled(ctx, id, integrationSettleExpectations{
 					name:          expectedName,
 					customHeaders: &expectedHeaders,
-				}, 60*time.Second); err == nil && settled != nil {
+				}, readTimeout.Wait(integrationReadSettleTimeout)); err == nil && settled != nil {
 					integration = settled
 				} else if settled != nil {
 					integration = settled
@@ -1222,6 +1388,10 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// value_wo is sent in place of value but never written to state.
+	storedValue := plan.Value
+	plan.Value = integrationRequestValue(plan, config)
+
 	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -1231,6 +1401,14 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := optimeout.Update(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := updateTimeout.Context(ctx)
+	defer cancel()
+
 	// Create update request with same structure as create request
 	integrationTypeAPI := TransformIntegrationTypeToAPI(plan.Type.ValueString())
 
@@ -1416,9 +1594,10 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 			resp.Diagnostics.AddError("Update cancelled", ctx.Err().Error())
 			return
 		}
-		resp.Diagnostics.AddError(
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, integrationAPIFieldNames,
 			"Error updating integration",
 			"Could not update integration, unexpected error: "+err.Error(),
+			err,
 		)
 		return
 	}
@@ -1429,7 +1608,7 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 		customHeaders:          customHeaders,
 		enableNotificationsFor: convertNotificationsForToString(plan.EnableNotificationsFor.ValueInt64()),
 		sslExpirationReminder:  &expectedSSLExpirationReminder,
-	}, 90*time.Second)
+	}, updateTimeout.Wait(integrationSettleTimeout))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Integration update did not settle in time",
@@ -1456,6 +1635,7 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Set state to fully populated data
+	plan.Value = storedValue
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1481,17 +1661,29 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 		)
 		return
 	}
+	if !deletionprotection.CheckDelete(state.DeletionProtection, r.protectAll, "integration", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	deleteTimeout, diags := optimeout.Delete(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := deleteTimeout.Context(ctx)
+	defer cancel()
 
 	err = r.client.DeleteIntegration(ctx, id)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		apidiag.AddRequestError(&resp.Diagnostics,
 			"Error deleting integration",
 			"Could not delete integration, unexpected error: "+err.Error(),
+			err,
 		)
 		return
 	}
 
-	err = r.client.WaitIntegrationDeleted(ctx, id, 2*time.Minute)
+	err = r.client.WaitIntegrationDeleted(ctx, id, deleteTimeout.Wait(integrationDeleteTimeout))
 	if err != nil {
 		resp.Diagnostics.AddError("Timed out waiting for deletion", err.Error())
 		return // resource will be kept in state and self healed on read or via next apply
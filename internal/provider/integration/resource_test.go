@@ -2,17 +2,24 @@ package integration
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
 )
 
 func TestPagerDutyLocationFromAPI(t *testing.T) {
@@ -188,6 +195,142 @@ func TestIntegrationCreateLockKeyNormalizesAndHashesValue(t *testing.T) {
 	}
 }
 
+func TestIntegrationCreateTokenAdoptsEarlierCreate(t *testing.T) {
+	t.Parallel()
+
+	data := &client.SlackIntegrationData{FriendlyName: "alerts", WebhookURL: "https://hooks.slack.com/x"}
+	stamp := &providerclient.OwnershipStamp{Key: "managed_by", Value: "terraform:prod"}
+	token, err := integrationCreateToken("Slack", data, stamp)
+	if err != nil {
+		t.Fatalf("token: %v", err)
+	}
+	if again, _ := integrationCreateToken("Slack", data, &providerclient.OwnershipStamp{Key: "managed_by", Value: "terraform:prod"}); again != token {
+		t.Fatalf("expected the same create to derive the same token, got %q and %q", token, again)
+	}
+	if other, _ := integrationCreateToken("Slack", data, &providerclient.OwnershipStamp{Key: "managed_by", Value: "terraform:staging"}); other == token {
+		t.Fatalf("expected another ownership stamp to derive another token, got %q twice", token)
+	}
+	named, err := withCreateTokenName(data, token)
+	if err != nil {
+		t.Fatalf("withCreateTokenName: %v", err)
+	}
+	if named["friendlyName"] != "alerts [tf-"+token+"]" || data.FriendlyName != "alerts" {
+		t.Fatalf("unexpected token name %v", named["friendlyName"])
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":1,"friendlyName":"alerts","type":"Slack"},
+			{"id":2,"friendlyName":"alerts [tf-` + token + `]","type":"Slack"}
+		]}`))
+	}))
+	defer srv.Close()
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(srv.URL)
+
+	found, err := findIntegrationByCreateToken(context.Background(), apiClient, token)
+	if err != nil || found == nil || found.ID != 2 {
+		t.Fatalf("expected integration 2, got %#v, %v", found, err)
+	}
+}
+
+func TestCreateIntegrationAdoptsIntegrationLeftByAnotherProcess(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		stored []string
+		posts  int
+		// listsOK is how many more listings succeed; -1 means all of them.
+		listsOK = 1
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/integrations":
+			var body struct {
+				Data map[string]any `json:"data"`
+			}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			posts++
+			if len(stored) > 0 {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"message":"Integration already exists"}`))
+				return
+			}
+			// The integration is stored, but the create answers with an error.
+			stored = append(stored, body.Data["friendlyName"].(string))
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"message":"internal error"}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/integrations":
+			if listsOK == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message":"listing unavailable"}`))
+				return
+			}
+			if listsOK > 0 {
+				listsOK--
+			}
+			items := make([]map[string]any, 0, len(stored))
+			for _, name := range stored {
+				items = append(items, map[string]any{"id": 501, "friendlyName": name, "type": "Slack"})
+			}
+			body, _ := json.Marshal(map[string]any{"data": items})
+			_, _ = w.Write(body)
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.RequestURI())
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(srv.URL)
+
+	create := func() (*client.Integration, string, diag.Diagnostics, error) {
+		// Every process derives the token from the request it sends.
+		data := &client.SlackIntegrationData{FriendlyName: "alerts", WebhookURL: "https://hooks.slack.com/x"}
+		token, err := integrationCreateToken("Slack", data, nil)
+		if err != nil {
+			t.Fatalf("token: %v", err)
+		}
+		named, err := withCreateTokenName(data, token)
+		if err != nil {
+			t.Fatalf("withCreateTokenName: %v", err)
+		}
+		r := &integrationResource{client: apiClient, createTokens: true}
+		var diags diag.Diagnostics
+		created, err := r.createIntegration(context.Background(), &client.CreateIntegrationRequest{Type: "Slack", Data: named}, token, &diags)
+		return created, token, diags, err
+	}
+
+	// The first process stores the integration, cannot find it after the
+	// error and the conflicting retry, and reports the failed lookup.
+	created, token, _, err := create()
+	if created != nil || err == nil || !strings.Contains(err.Error(), "listing unavailable") {
+		t.Fatalf("expected the create to fail reporting the lookup error, got %#v, %v", created, err)
+	}
+
+	// Another process derives the same token and adopts the integration
+	// without creating another.
+	mu.Lock()
+	listsOK = -1
+	postsBefore := posts
+	mu.Unlock()
+	created, again, diags, err := create()
+	if again != token {
+		t.Fatalf("expected the second process to derive token %q, got %q", token, again)
+	}
+	if err != nil || created == nil || created.ID != 501 || diags.WarningsCount() != 1 {
+		t.Fatalf("expected integration 501 to be adopted, got %#v, %v, %v", created, err, diags)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if posts != postsBefore {
+		t.Fatalf("expected no create from the second process, got %d", posts-postsBefore)
+	}
+}
+
 func TestLockIntegrationCreateSerializesSameKey(t *testing.T) {
 	t.Parallel()
 
@@ -482,3 +625,77 @@ func TestWebhookStateKeepsPreviousValuesWhenAPIOmitsConfig(t *testing.T) {
 		t.Fatalf("expected previous post_value to be preserved, got %q", gotPostValue.ValueString())
 	}
 }
+
+func TestIntegrationRequestValuePrefersWriteOnlyValue(t *testing.T) {
+	t.Parallel()
+
+	plan := integrationResourceModel{Value: types.StringNull()}
+	config := integrationResourceModel{ValueWO: types.StringValue("https://example.com/hook?token=secret")}
+	if got := integrationRequestValue(plan, config); got.ValueString() != "https://example.com/hook?token=secret" {
+		t.Fatalf("expected value_wo to be sent, got %s", got)
+	}
+
+	plan = integrationResourceModel{Value: types.StringValue("https://example.com/hook")}
+	config = integrationResourceModel{ValueWO: types.StringNull()}
+	if got := integrationRequestValue(plan, config); got.ValueString() != "https://example.com/hook" {
+		t.Fatalf("expected value to be sent without value_wo, got %s", got)
+	}
+}
+
+func TestIntegrationReadKeepsWriteOnlyValueOutOfState(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "GET /integrations/101" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"id":101,"friendlyName":"alerts","type":"` + TransformIntegrationTypeToAPI("slack") +
+			`","webhookURL":"https://hooks.slack.com/services/secret","enableNotificationsFor":"UpAndDown","sslExpirationReminder":false}`))
+	}))
+	defer srv.Close()
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(srv.URL)
+	r := &integrationResource{client: apiClient}
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, integrationResourceModel{
+		ID:                     types.StringValue("101"),
+		Name:                   types.StringValue("alerts"),
+		Type:                   types.StringValue("slack"),
+		Value:                  types.StringNull(),
+		CustomValue:            types.StringNull(),
+		EnableNotificationsFor: types.Int64Value(1),
+		SSLExpirationReminder:  types.BoolValue(false),
+		CustomHeaders:          types.MapNull(types.StringType),
+		Timeouts:               optimeout.Null(),
+		ValueWO:                types.StringNull(),
+		ValueWOVersion:         types.Int64Value(1),
+	})
+	if diags.HasError() {
+		t.Fatalf("building state: %v", diags)
+	}
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got integrationResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("reading state: %v", diags)
+	}
+	if !got.Value.IsNull() {
+		t.Fatalf("expected value managed by value_wo to stay null, got %s", got.Value)
+	}
+	if got.ValueWOVersion.ValueInt64() != 1 {
+		t.Fatalf("expected value_wo_version to be kept, got %s", got.ValueWOVersion)
+	}
+}
@@ -0,0 +1,82 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+var (
+	_ action.Action              = &testNotificationAction{}
+	_ action.ActionWithConfigure = &testNotificationAction{}
+)
+
+// NewTestNotificationAction returns the action that sends a test notification
+// through an integration.
+func NewTestNotificationAction() action.Action {
+	return &testNotificationAction{}
+}
+
+type testNotificationAction struct {
+	client *client.Client
+}
+
+type testNotificationActionModel struct {
+	IntegrationID types.Int64 `tfsdk:"integration_id"`
+}
+
+func (a *testNotificationAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = providerclient.FromActionConfigure(req, resp)
+}
+
+func (a *testNotificationAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_test_notification"
+}
+
+func (a *testNotificationAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sends a test notification through an UptimeRobot integration, for example after creating or rotating a webhook or Slack integration.",
+		Attributes: map[string]schema.Attribute{
+			"integration_id": schema.Int64Attribute{
+				Description: "ID of the integration.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *testNotificationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config testNotificationActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if a.client == nil {
+		resp.Diagnostics.AddError("Missing API Client", "The provider was not configured with an API client.")
+		return
+	}
+
+	id := config.IntegrationID.ValueInt64()
+	if err := a.client.TestIntegration(ctx, id); err != nil {
+		apidiag.AddRequestError(&resp.Diagnostics,
+			"Error sending test notification",
+			fmt.Sprintf("Could not send a test notification through integration %d, unexpected error: %s", id, err),
+			err,
+		)
+		return
+	}
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Sent a test notification through integration %d", id)})
+	}
+}
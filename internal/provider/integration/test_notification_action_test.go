@@ -0,0 +1,50 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+func TestTestNotificationAction_Invoke(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotPath = req.Method + " " + req.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(srv.URL)
+
+	a := NewTestNotificationAction()
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: apiClient}, &action.ConfigureResponse{})
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"integration_id": tftypes.NewValue(tftypes.Number, 7),
+		}),
+	}
+
+	var resp action.InvokeResponse
+	a.Invoke(ctx, action.InvokeRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if gotPath != "POST /integrations/7/test" {
+		t.Fatalf("expected POST /integrations/7/test, got %q", gotPath)
+	}
+}
@@ -0,0 +1,53 @@
+package legacyprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Address is the registry address of the community louy/uptimerobot
+// provider, which was built against the v2 API.
+const Address = "registry.terraform.io/louy/uptimerobot"
+
+// SourceState decodes the state of a typeName resource moved from the
+// community provider using source, a schema declaring only the attributes the
+// mover reads. It returns nil when req moves some other resource, so the mover
+// can leave the target state alone, and when the state cannot be decoded, in
+// which case an error is added to diags.
+func SourceState(
+	ctx context.Context,
+	req resource.MoveStateRequest,
+	typeName string,
+	source schema.Schema,
+	diags *diag.Diagnostics,
+) *tfsdk.State {
+	if req.SourceProviderAddress != Address || req.SourceTypeName != typeName {
+		return nil
+	}
+	if req.SourceRawState == nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The %s state from %s is empty.", typeName, Address),
+		)
+		return nil
+	}
+
+	raw, err := req.SourceRawState.UnmarshalWithOpts(source.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	})
+	if err != nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("Could not read the %s state from %s (schema version %d): %s", typeName, Address, req.SourceSchemaVersion, err),
+		)
+		return nil
+	}
+	return &tfsdk.State{Schema: source, Raw: raw}
+}
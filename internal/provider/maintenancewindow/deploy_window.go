@@ -0,0 +1,432 @@
+package maintenancewindow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+	_ "time/tzdata" // time_zone must resolve on hosts without a zoneinfo database.
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+var (
+	_ ephemeral.EphemeralResource                     = &deployWindowEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure        = &deployWindowEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &deployWindowEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig   = &deployWindowEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew            = &deployWindowEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose            = &deployWindowEphemeralResource{}
+)
+
+// Defaults for omitted deploy window attributes.
+const (
+	deployWindowDefaultName     = "Terraform deploy"
+	deployWindowDefaultDuration = 30 * time.Minute
+	deployWindowDefaultTimeZone = "UTC"
+)
+
+// deployWindowPrivateKey is the private data key holding deployWindowPrivate.
+const deployWindowPrivateKey = "deploy_window"
+
+// NewDeployWindowEphemeralResource returns the ephemeral maintenance window
+// that suppresses alerts while Terraform runs.
+func NewDeployWindowEphemeralResource() ephemeral.EphemeralResource {
+	return &deployWindowEphemeralResource{now: time.Now}
+}
+
+type deployWindowEphemeralResource struct {
+	client *client.Client
+	now    func() time.Time
+}
+
+type deployWindowModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	MonitorIDs types.Set    `tfsdk:"monitor_ids"`
+	Tags       types.Set    `tfsdk:"tags"`
+	Duration   types.String `tfsdk:"duration"`
+	TimeZone   types.String `tfsdk:"time_zone"`
+	StartsAt   types.String `tfsdk:"starts_at"`
+	EndsAt     types.String `tfsdk:"ends_at"`
+}
+
+// deployWindowPrivate is what Renew and Close need to find and extend the
+// window. Renew rewrites the whole window because the API replaces it.
+type deployWindowPrivate struct {
+	ID         int64     `json:"id"`
+	Name       string    `json:"name"`
+	MonitorIDs []int64   `json:"monitor_ids"`
+	Duration   string    `json:"duration"`
+	TimeZone   string    `json:"time_zone"`
+	Start      time.Time `json:"start"`
+}
+
+func (r *deployWindowEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.client = providerclient.FromEphemeralResourceConfigure(req, resp)
+}
+
+func (r *deployWindowEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy_window"
+}
+
+func (r *deployWindowEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Opens a one-time maintenance window for the duration of a Terraform run, so monitors " +
+			"touched by a deploy do not alert. The window is created when Terraform opens the resource, extended " +
+			"while the run lasts and deleted when the run finishes. A run that crashes leaves a window that ends " +
+			"on its own after `duration`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the maintenance window.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the maintenance window. Defaults to `" + deployWindowDefaultName + "`.",
+			},
+			"monitor_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the monitors the window covers.",
+			},
+			"tags": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tag names. The window also covers every monitor carrying any of these tags when it opens.",
+			},
+			"duration": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "How long the window lasts after it opens and after each renewal, as a Go duration " +
+					"such as `30m` or `1h`. Defaults to `30m`. Must be at least one minute.",
+			},
+			"time_zone": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "IANA time zone of the UptimeRobot account, such as `Europe/Berlin`. The API reads " +
+					"maintenance window dates and times in the account time zone. Defaults to `UTC`.",
+			},
+			"starts_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the window started, in RFC 3339 format.",
+			},
+			"ends_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the window ends unless it is renewed or closed, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func (r *deployWindowEphemeralResource) ConfigValidators(_ context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.AtLeastOneOf(
+			path.MatchRoot("monitor_ids"),
+			path.MatchRoot("tags"),
+		),
+	}
+}
+
+func (r *deployWindowEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config deployWindowModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.Duration.IsUnknown() {
+		_, d := deployWindowDuration(config.Duration)
+		resp.Diagnostics.Append(d...)
+	}
+	if !config.TimeZone.IsUnknown() {
+		_, d := deployWindowLocation(config.TimeZone)
+		resp.Diagnostics.Append(d...)
+	}
+}
+
+func (r *deployWindowEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config deployWindowModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		resp.Diagnostics.AddError("Missing API Client", "The provider was not configured with an API client.")
+		return
+	}
+
+	window, diags := r.openWindow(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if window == nil {
+		// Read-only mode: nothing was opened, so there is nothing to renew
+		// or close.
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+		return
+	}
+	resp.Diagnostics.Append(setDeployWindowPrivate(ctx, resp.Private, *window)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	duration, _ := time.ParseDuration(window.Duration)
+	config.ID = types.StringValue(strconv.FormatInt(window.ID, 10))
+	config.StartsAt = types.StringValue(window.Start.Format(time.RFC3339))
+	config.EndsAt = types.StringValue(window.Start.Add(duration).Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+	resp.RenewAt = deployWindowRenewAt(r.now(), duration)
+}
+
+// openWindow creates the once window starting at the current minute. In
+// read-only mode it creates nothing and returns a nil window with a warning,
+// since Open also runs during plan, which read-only mode must not break.
+func (r *deployWindowEphemeralResource) openWindow(ctx context.Context, config deployWindowModel) (*deployWindowPrivate, diag.Diagnostics) {
+	duration, diags := deployWindowDuration(config.Duration)
+	loc, d := deployWindowLocation(config.TimeZone)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if r.client.ReadOnly() {
+		diags.AddWarning(
+			"Deploy window not opened",
+			"The provider is configured with read_only = true or UPTIMEROBOT_READ_ONLY, so no maintenance window was created "+
+				"and alerts are not suppressed. id, starts_at and ends_at are null.",
+		)
+		return nil, diags
+	}
+
+	monitorIDs, d := r.resolveMonitorIDs(ctx, config)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	window := deployWindowPrivate{
+		Name:       deployWindowDefaultName,
+		MonitorIDs: monitorIDs,
+		Duration:   duration.String(),
+		TimeZone:   loc.String(),
+		Start:      r.now().In(loc).Truncate(time.Minute),
+	}
+	if v := config.Name.ValueString(); v != "" {
+		window.Name = v
+	}
+	end := window.Start.Add(duration)
+
+	date := window.Start.Format("2006-01-02")
+	autoAddMonitors := false
+	created, err := r.client.CreateMaintenanceWindow(ctx, &client.CreateMaintenanceWindowRequest{
+		Name:            window.Name,
+		Interval:        "once",
+		Date:            &date,
+		Time:            window.Start.Format("15:04:05"),
+		Duration:        deployWindowMinutes(window.Start, end),
+		AutoAddMonitors: &autoAddMonitors,
+		MonitorIDs:      &window.MonitorIDs,
+	})
+	if err != nil {
+		apidiag.AddRequestError(&diags, "Error opening deploy window", "Could not create maintenance window, unexpected error: "+err.Error(), err)
+		return nil, diags
+	}
+	window.ID = created.ID
+	return &window, diags
+}
+
+func (r *deployWindowEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	window, diags := getDeployWindowPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || window == nil {
+		return
+	}
+	if r.client == nil {
+		resp.Diagnostics.AddError("Missing API Client", "The provider was not configured with an API client.")
+		return
+	}
+
+	renewAt, diags := r.extendWindow(ctx, window)
+	resp.Diagnostics.Append(diags...)
+	resp.RenewAt = renewAt
+}
+
+// extendWindow moves the end of the window to duration from now and returns
+// when it should be extended next.
+func (r *deployWindowEphemeralResource) extendWindow(ctx context.Context, window *deployWindowPrivate) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	duration, err := time.ParseDuration(window.Duration)
+	if err != nil {
+		diags.AddError("Error renewing deploy window", "Could not read the window duration: "+err.Error())
+		return time.Time{}, diags
+	}
+
+	now := r.now()
+	date := window.Start.Format("2006-01-02")
+	_, err = r.client.UpdateMaintenanceWindow(ctx, window.ID, &client.UpdateMaintenanceWindowRequest{
+		Name:       window.Name,
+		Interval:   "once",
+		Date:       &date,
+		Time:       window.Start.Format("15:04:05"),
+		Duration:   deployWindowMinutes(window.Start, now.Add(duration)),
+		MonitorIDs: &window.MonitorIDs,
+	})
+	if err != nil {
+		apidiag.AddRequestError(&diags,
+			"Error renewing deploy window",
+			fmt.Sprintf("Could not extend maintenance window %d, unexpected error: %s", window.ID, err),
+			err,
+		)
+		return time.Time{}, diags
+	}
+	return deployWindowRenewAt(now, duration), diags
+}
+
+func (r *deployWindowEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	window, diags := getDeployWindowPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || window == nil {
+		return
+	}
+	if r.client == nil {
+		resp.Diagnostics.AddError("Missing API Client", "The provider was not configured with an API client.")
+		return
+	}
+
+	resp.Diagnostics.Append(r.closeWindow(ctx, window)...)
+}
+
+// closeWindow deletes the window. A window that is already gone, for example
+// because someone removed it by hand, is not an error.
+func (r *deployWindowEphemeralResource) closeWindow(ctx context.Context, window *deployWindowPrivate) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err := r.client.DeleteMaintenanceWindow(ctx, window.ID); err != nil && !client.IsNotFound(err) {
+		apidiag.AddRequestError(&diags,
+			"Error closing deploy window",
+			fmt.Sprintf("Could not delete maintenance window %d, unexpected error: %s. It ends on its own when its duration runs out.", window.ID, err),
+			err,
+		)
+	}
+	return diags
+}
+
+// resolveMonitorIDs returns the configured monitor IDs plus the monitors
+// carrying any of the configured tags, sorted and deduplicated.
+func (r *deployWindowEphemeralResource) resolveMonitorIDs(ctx context.Context, config deployWindowModel) ([]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var ids []int64
+	if !config.MonitorIDs.IsNull() {
+		diags.Append(config.MonitorIDs.ElementsAs(ctx, &ids, false)...)
+	}
+	var tags []string
+	if !config.Tags.IsNull() {
+		diags.Append(config.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, tag := range tags {
+		monitors, err := r.client.GetMonitorsFiltered(ctx, client.MonitorListFilters{Tags: []string{tag}})
+		if err != nil {
+			diags.AddError("Error opening deploy window", fmt.Sprintf("Could not list monitors tagged %q, unexpected error: %s", tag, err))
+			return nil, diags
+		}
+		for _, m := range monitors {
+			ids = append(ids, m.ID)
+		}
+	}
+
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+	if len(ids) == 0 {
+		diags.AddError(
+			"No monitors to cover",
+			"The deploy window would not cover any monitor: monitor_ids is empty and no monitor carries the configured tags.",
+		)
+	}
+	return ids, diags
+}
+
+func deployWindowDuration(v types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if v.IsNull() {
+		return deployWindowDefaultDuration, diags
+	}
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil || d < time.Minute {
+		diags.AddAttributeError(
+			path.Root("duration"),
+			"Invalid Deploy Window Duration",
+			fmt.Sprintf("Expected a duration of at least one minute such as \"30m\", got %q.", v.ValueString()),
+		)
+	}
+	return d, diags
+}
+
+func deployWindowLocation(v types.String) (*time.Location, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	name := deployWindowDefaultTimeZone
+	if !v.IsNull() {
+		name = v.ValueString()
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("time_zone"),
+			"Invalid Time Zone",
+			fmt.Sprintf("Expected an IANA time zone such as \"Europe/Berlin\", got %q.", name),
+		)
+	}
+	return loc, diags
+}
+
+// deployWindowMinutes is the window duration the API needs to cover start to
+// end, rounded up to whole minutes.
+func deployWindowMinutes(start, end time.Time) int {
+	return int((end.Sub(start) + time.Minute - 1) / time.Minute)
+}
+
+// deployWindowRenewAt renews halfway through the remaining window, leaving
+// Terraform time to retry before it ends.
+func deployWindowRenewAt(now time.Time, duration time.Duration) time.Time {
+	return now.Add(duration / 2)
+}
+
+type privateData interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func setDeployWindowPrivate(ctx context.Context, private privateData, window deployWindowPrivate) diag.Diagnostics {
+	b, err := json.Marshal(window)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error opening deploy window", "Could not encode the window: "+err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, deployWindowPrivateKey, b)
+}
+
+func getDeployWindowPrivate(ctx context.Context, private privateData) (*deployWindowPrivate, diag.Diagnostics) {
+	b, diags := private.GetKey(ctx, deployWindowPrivateKey)
+	if diags.HasError() || len(b) == 0 {
+		return nil, diags
+	}
+	var window deployWindowPrivate
+	if err := json.Unmarshal(b, &window); err != nil {
+		diags.AddError("Error reading deploy window", "Could not decode the window: "+err.Error())
+		return nil, diags
+	}
+	return &window, diags
+}
@@ -0,0 +1,248 @@
+package maintenancewindow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+type deployWindowCall struct {
+	method string
+	path   string
+	body   map[string]any
+}
+
+func newDeployWindowTestServer(t *testing.T, deleteStatus int) (*client.Client, *[]deployWindowCall) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var calls []deployWindowCall
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		call := deployWindowCall{method: req.Method, path: req.URL.Path}
+		if req.Body != nil {
+			_ = json.NewDecoder(req.Body).Decode(&call.body)
+		}
+		mu.Lock()
+		calls = append(calls, call)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/monitors":
+			switch req.URL.Query().Get("tags") {
+			case "web":
+				_, _ = w.Write([]byte(`{"data":[{"id":7},{"id":3}]}`))
+			default:
+				_, _ = w.Write([]byte(`{"data":[{"id":3}]}`))
+			}
+		case req.Method == http.MethodDelete:
+			w.WriteHeader(deleteStatus)
+		default:
+			_, _ = w.Write([]byte(`{"id":55,"name":"Terraform deploy","interval":"once"}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(srv.URL)
+	return apiClient, &calls
+}
+
+func TestDeployWindow_OpenRenewClose(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	apiClient, calls := newDeployWindowTestServer(t, http.StatusOK)
+
+	now := time.Date(2026, 3, 1, 9, 14, 40, 0, time.UTC)
+	r := &deployWindowEphemeralResource{client: apiClient, now: func() time.Time { return now }}
+
+	config := deployWindowModel{
+		Name:       types.StringNull(),
+		MonitorIDs: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(9)}),
+		Tags:       types.SetValueMust(types.StringType, []attr.Value{types.StringValue("web"), types.StringValue("api")}),
+		Duration:   types.StringValue("20m"),
+		TimeZone:   types.StringValue("Europe/Berlin"),
+	}
+	window, diags := r.openWindow(ctx, config)
+	if diags.HasError() {
+		t.Fatalf("open: %v", diags)
+	}
+	if window.ID != 55 || !slices.Equal(window.MonitorIDs, []int64{3, 7, 9}) {
+		t.Fatalf("unexpected window: %+v", window)
+	}
+
+	create := (*calls)[len(*calls)-1]
+	if create.method != http.MethodPost || create.path != "/maintenance-windows" {
+		t.Fatalf("expected POST /maintenance-windows, got %s %s", create.method, create.path)
+	}
+	want := map[string]any{
+		"name":            "Terraform deploy",
+		"interval":        "once",
+		"date":            "2026-03-01",
+		"time":            "10:14:00",
+		"duration":        float64(20),
+		"autoAddMonitors": false,
+	}
+	for k, v := range want {
+		if create.body[k] != v {
+			t.Fatalf("create body %s: expected %v, got %v", k, v, create.body[k])
+		}
+	}
+
+	// 25 minutes later the window must reach 20 minutes past now: 45 minutes
+	// from 10:14, rounded up from 45m40s.
+	now = now.Add(25 * time.Minute)
+	renewAt, diags := r.extendWindow(ctx, window)
+	if diags.HasError() {
+		t.Fatalf("renew: %v", diags)
+	}
+	if !renewAt.Equal(now.Add(10 * time.Minute)) {
+		t.Fatalf("expected renewal at %s, got %s", now.Add(10*time.Minute), renewAt)
+	}
+	update := (*calls)[len(*calls)-1]
+	if update.method != http.MethodPatch || update.path != "/maintenance-windows/55" {
+		t.Fatalf("expected PATCH /maintenance-windows/55, got %s %s", update.method, update.path)
+	}
+	if update.body["duration"] != float64(46) || update.body["time"] != "10:14:00" {
+		t.Fatalf("unexpected update body: %v", update.body)
+	}
+
+	if diags := r.closeWindow(ctx, window); diags.HasError() {
+		t.Fatalf("close: %v", diags)
+	}
+	del := (*calls)[len(*calls)-1]
+	if del.method != http.MethodDelete || del.path != "/maintenance-windows/55" {
+		t.Fatalf("expected DELETE /maintenance-windows/55, got %s %s", del.method, del.path)
+	}
+}
+
+func TestDeployWindow_CloseIgnoresMissingWindow(t *testing.T) {
+	t.Parallel()
+
+	apiClient, _ := newDeployWindowTestServer(t, http.StatusNotFound)
+	r := &deployWindowEphemeralResource{client: apiClient, now: time.Now}
+	if diags := r.closeWindow(context.Background(), &deployWindowPrivate{ID: 55}); diags.HasError() {
+		t.Fatalf("expected no error for a deleted window, got %v", diags)
+	}
+}
+
+func TestDeployWindow_OpenWithoutMonitors(t *testing.T) {
+	t.Parallel()
+
+	apiClient, calls := newDeployWindowTestServer(t, http.StatusOK)
+	r := &deployWindowEphemeralResource{client: apiClient, now: time.Now}
+
+	config := deployWindowModel{
+		MonitorIDs: types.SetNull(types.Int64Type),
+		Tags:       types.SetValueMust(types.StringType, []attr.Value{}),
+		Duration:   types.StringNull(),
+		TimeZone:   types.StringNull(),
+	}
+	if _, diags := r.openWindow(context.Background(), config); !diags.HasError() {
+		t.Fatal("expected an error when no monitor is covered")
+	}
+	if len(*calls) != 0 {
+		t.Fatalf("expected no API calls, got %v", *calls)
+	}
+}
+
+func TestDeployWindow_OpenReadOnly(t *testing.T) {
+	t.Parallel()
+
+	apiClient, calls := newDeployWindowTestServer(t, http.StatusOK)
+	apiClient.SetReadOnly(true)
+	r := &deployWindowEphemeralResource{client: apiClient, now: time.Now}
+
+	config := deployWindowModel{
+		MonitorIDs: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(9)}),
+		Tags:       types.SetNull(types.StringType),
+		Duration:   types.StringNull(),
+		TimeZone:   types.StringNull(),
+	}
+	window, diags := r.openWindow(context.Background(), config)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning in read-only mode, got %v", diags)
+	}
+	if window != nil {
+		t.Fatalf("expected no window in read-only mode, got %+v", window)
+	}
+	if len(*calls) != 0 {
+		t.Fatalf("expected no API calls, got %v", *calls)
+	}
+}
+
+func TestDeployWindowDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      types.String
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: types.StringNull(), want: 30 * time.Minute},
+		{in: types.StringValue("1h"), want: time.Hour},
+		{in: types.StringValue("30s"), wantErr: true},
+		{in: types.StringValue("soon"), wantErr: true},
+	}
+	for _, tt := range tests {
+		got, diags := deployWindowDuration(tt.in)
+		if diags.HasError() != tt.wantErr {
+			t.Fatalf("%s: expected error=%v, got %v", tt.in, tt.wantErr, diags)
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Fatalf("%s: expected %s, got %s", tt.in, tt.want, got)
+		}
+	}
+}
+
+type fakePrivateData map[string][]byte
+
+func (f fakePrivateData) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return f[key], nil
+}
+
+func (f fakePrivateData) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	f[key] = value
+	return nil
+}
+
+func TestDeployWindowPrivate_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	private := fakePrivateData{}
+
+	if got, diags := getDeployWindowPrivate(ctx, private); got != nil || diags.HasError() {
+		t.Fatalf("expected no window before Open, got %+v %v", got, diags)
+	}
+
+	want := deployWindowPrivate{
+		ID:         55,
+		Name:       "deploy",
+		MonitorIDs: []int64{1, 2},
+		Duration:   "30m0s",
+		TimeZone:   "UTC",
+		Start:      time.Date(2026, 3, 1, 9, 14, 0, 0, time.UTC),
+	}
+	if diags := setDeployWindowPrivate(ctx, private, want); diags.HasError() {
+		t.Fatalf("set: %v", diags)
+	}
+	got, diags := getDeployWindowPrivate(ctx, private)
+	if diags.HasError() {
+		t.Fatalf("get: %v", diags)
+	}
+	if got.ID != want.ID || !got.Start.Equal(want.Start) || !slices.Equal(got.MonitorIDs, want.MonitorIDs) {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+}
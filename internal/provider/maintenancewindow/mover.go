@@ -0,0 +1,163 @@
+package maintenancewindow
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/legacyprovider"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
+)
+
+const maintenanceWindowResourceTypeName = "uptimerobot_maintenance_window"
+
+// legacyMaintenanceWindowModel is the louy/uptimerobot
+// uptimerobot_maintenance_window state, which mirrors the v2 mwindow object.
+type legacyMaintenanceWindowModel struct {
+	ID           types.String `tfsdk:"id"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	Type         types.String `tfsdk:"type"`
+	Value        types.String `tfsdk:"value"`
+	StartTime    types.String `tfsdk:"start_time"`
+	Duration     types.Int64  `tfsdk:"duration"`
+}
+
+func legacyMaintenanceWindowSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":            schema.StringAttribute{Computed: true},
+			"friendly_name": schema.StringAttribute{Required: true},
+			"type":          schema.StringAttribute{Required: true},
+			"value":         schema.StringAttribute{Optional: true},
+			"start_time":    schema.StringAttribute{Required: true},
+			"duration":      schema.Int64Attribute{Required: true},
+		},
+	}
+}
+
+// legacyMaintenanceWindowIntervals maps v2 window types, by name or by their
+// numeric API value, to interval values.
+var legacyMaintenanceWindowIntervals = map[string]string{
+	"once":    "once",
+	"daily":   "daily",
+	"weekly":  "weekly",
+	"monthly": "monthly",
+	"1":       "once",
+	"2":       "daily",
+	"3":       "weekly",
+	"4":       "monthly",
+}
+
+// MoveState moves uptimerobot_maintenance_window resources of the community
+// louy/uptimerobot provider to this resource.
+func (r *maintenanceWindowResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: moveMaintenanceWindowFromLegacy},
+	}
+}
+
+func moveMaintenanceWindowFromLegacy(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	source := legacyprovider.SourceState(ctx, req, maintenanceWindowResourceTypeName, legacyMaintenanceWindowSchema(), &resp.Diagnostics)
+	if source == nil {
+		return
+	}
+
+	var legacy legacyMaintenanceWindowModel
+	resp.Diagnostics.Append(source.Get(ctx, &legacy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	moved, diags := maintenanceWindowFromLegacy(ctx, legacy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, moved)...)
+}
+
+// maintenanceWindowFromLegacy translates a v2 window. v2 stored the start of a
+// once window as a Unix timestamp and the others as HH:mm, and the days of
+// weekly and monthly windows as a dash separated value such as "1-3-5".
+func maintenanceWindowFromLegacy(ctx context.Context, legacy legacyMaintenanceWindowModel) (maintenanceWindowResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	interval, ok := legacyMaintenanceWindowIntervals[strings.ToLower(strings.TrimSpace(legacy.Type.ValueString()))]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("interval"),
+			"Unsupported Maintenance Window Type",
+			fmt.Sprintf("The %s maintenance window type %q is not supported.", legacyprovider.Address, legacy.Type.ValueString()),
+		)
+		return maintenanceWindowResourceModel{}, diags
+	}
+
+	moved := maintenanceWindowResourceModel{
+		ID:         legacy.ID,
+		Name:       legacy.FriendlyName,
+		Interval:   types.StringValue(interval),
+		Duration:   legacy.Duration,
+		MonitorIDs: types.SetNull(types.Int64Type),
+		Days:       types.SetNull(types.Int64Type),
+		Timeouts:   optimeout.Null(),
+	}
+
+	start := strings.TrimSpace(legacy.StartTime.ValueString())
+	if interval == "once" {
+		unix, err := strconv.ParseInt(start, 10, 64)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("date"),
+				"Invalid Maintenance Window Start",
+				fmt.Sprintf("Expected a Unix timestamp for a once window, got %q.", start),
+			)
+			return maintenanceWindowResourceModel{}, diags
+		}
+		t := time.Unix(unix, 0).UTC()
+		moved.Date = types.StringValue(t.Format("2006-01-02"))
+		moved.Time = types.StringValue(t.Format("15:04:05"))
+	} else {
+		t, err := time.Parse("15:04", start)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("time"),
+				"Invalid Maintenance Window Start",
+				fmt.Sprintf("Expected HH:mm for a %s window, got %q.", interval, start),
+			)
+			return maintenanceWindowResourceModel{}, diags
+		}
+		moved.Time = types.StringValue(t.Format("15:04:05"))
+	}
+
+	if interval == "weekly" || interval == "monthly" {
+		var days []int64
+		for _, part := range strings.Split(legacy.Value.ValueString(), "-") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			day, err := strconv.ParseInt(part, 10, 64)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("days"),
+					"Invalid Maintenance Window Days",
+					fmt.Sprintf("Could not parse day %q in %q.", part, legacy.Value.ValueString()),
+				)
+				return maintenanceWindowResourceModel{}, diags
+			}
+			days = append(days, day)
+		}
+		set, d := types.SetValueFrom(ctx, types.Int64Type, normalizeDays(days))
+		diags.Append(d...)
+		moved.Days = set
+	}
+
+	return moved, diags
+}
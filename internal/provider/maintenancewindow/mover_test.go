@@ -0,0 +1,93 @@
+package maintenancewindow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMaintenanceWindowFromLegacy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		legacy   legacyMaintenanceWindowModel
+		wantDate string
+		wantTime string
+		wantDays []int64
+		wantErr  bool
+	}{
+		{
+			name: "once window from a timestamp",
+			legacy: legacyMaintenanceWindowModel{
+				Type:      types.StringValue("once"),
+				StartTime: types.StringValue("1767268800"),
+				Duration:  types.Int64Value(30),
+			},
+			wantDate: "2026-01-01",
+			wantTime: "12:00:00",
+		},
+		{
+			name: "weekly window by API value",
+			legacy: legacyMaintenanceWindowModel{
+				Type:      types.StringValue("3"),
+				Value:     types.StringValue("5-1-3"),
+				StartTime: types.StringValue("02:30"),
+				Duration:  types.Int64Value(60),
+			},
+			wantTime: "02:30:00",
+			wantDays: []int64{1, 3, 5},
+		},
+		{
+			name: "unknown type",
+			legacy: legacyMaintenanceWindowModel{
+				Type:      types.StringValue("yearly"),
+				StartTime: types.StringValue("02:30"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed start",
+			legacy: legacyMaintenanceWindowModel{
+				Type:      types.StringValue("daily"),
+				StartTime: types.StringValue("2:30pm"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := maintenanceWindowFromLegacy(ctx, tt.legacy)
+			if tt.wantErr {
+				if !diags.HasError() {
+					t.Fatalf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got.Date.ValueString() != tt.wantDate || got.Time.ValueString() != tt.wantTime {
+				t.Fatalf("expected %q %q, got %s %s", tt.wantDate, tt.wantTime, got.Date, got.Time)
+			}
+			var days []int64
+			if !got.Days.IsNull() {
+				got.Days.ElementsAs(ctx, &days, false)
+			}
+			if len(days) != len(tt.wantDays) {
+				t.Fatalf("expected days %v, got %v", tt.wantDays, days)
+			}
+			for i := range days {
+				if days[i] != tt.wantDays[i] {
+					t.Fatalf("expected days %v, got %v", tt.wantDays, days)
+				}
+			}
+		})
+	}
+}
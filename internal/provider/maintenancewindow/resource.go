@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -23,7 +24,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apiretry"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
 )
 
@@ -42,6 +45,7 @@ var (
 	_ resource.ResourceWithModifyPlan     = &maintenanceWindowResource{}
 	_ resource.ResourceWithValidateConfig = &maintenanceWindowResource{}
 	_ resource.ResourceWithUpgradeState   = &maintenanceWindowResource{}
+	_ resource.ResourceWithMoveState      = &maintenanceWindowResource{}
 )
 
 // NewResource returns the maintenance window resource.
@@ -56,18 +60,25 @@ type maintenanceWindowResource struct {
 
 // maintenanceWindowResourceModel maps the resource schema data.
 type maintenanceWindowResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Interval        types.String `tfsdk:"interval"`
-	Date            types.String `tfsdk:"date"`
-	Time            types.String `tfsdk:"time"`
-	Duration        types.Int64  `tfsdk:"duration"`
-	AutoAddMonitors types.Bool   `tfsdk:"auto_add_monitors"`
-	MonitorIDs      types.Set    `tfsdk:"monitor_ids"`
-	Days            types.Set    `tfsdk:"days"`
-	Status          types.String `tfsdk:"status"`
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	Interval        types.String   `tfsdk:"interval"`
+	Date            types.String   `tfsdk:"date"`
+	Time            types.String   `tfsdk:"time"`
+	Duration        types.Int64    `tfsdk:"duration"`
+	AutoAddMonitors types.Bool     `tfsdk:"auto_add_monitors"`
+	MonitorIDs      types.Set      `tfsdk:"monitor_ids"`
+	Days            types.Set      `tfsdk:"days"`
+	Status          types.String   `tfsdk:"status"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
+// Default waits used when the timeouts block does not override them.
+const (
+	maintenanceWindowSettleTimeout = 60 * time.Second
+	maintenanceWindowDeleteTimeout = 2 * time.Minute
+)
+
 // Configure adds the provider configured client to the resource.
 func (r *maintenanceWindowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.client = providerclient.FromResourceConfigure(req, resp)
@@ -164,6 +175,9 @@ func (r *maintenanceWindowResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			optimeout.BlockName: optimeout.Block(),
+		},
 	}
 }
 
@@ -344,6 +358,14 @@ func (r *maintenanceWindowResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, diags := optimeout.Create(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := createTimeout.Context(ctx)
+	defer cancel()
+
 	// Create new maintenance window
 	mw := &client.CreateMaintenanceWindowRequest{
 		Name:     plan.Name.ValueString(),
@@ -413,9 +435,10 @@ func (r *maintenanceWindowResource) Create(ctx context.Context, req resource.Cre
 	// Create maintenance window
 	newMW, err := r.createMaintenanceWindowWithRetry(ctx, mw)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, nil,
 			"Error creating maintenance window",
 			"Could not create maintenance window, unexpected error: "+err.Error(),
+			err,
 		)
 		return
 	}
@@ -527,6 +550,14 @@ func (r *maintenanceWindowResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	readTimeout, diags := optimeout.Read(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := readTimeout.Context(ctx)
+	defer cancel()
+
 	mw, err := r.client.GetMaintenanceWindow(ctx, id)
 	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
@@ -540,7 +571,7 @@ func (r *maintenanceWindowResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	mw = r.stabilizeMaintenanceWindowReadSnapshot(ctx, id, state, mw)
+	mw = r.stabilizeMaintenanceWindowReadSnapshot(ctx, id, state, mw, readTimeout)
 
 	// Map response body to schema
 	state.Name = types.StringValue(mw.Name)
@@ -613,6 +644,14 @@ func (r *maintenanceWindowResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	updateTimeout, diags := optimeout.Update(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := updateTimeout.Context(ctx)
+	defer cancel()
+
 	// Create update request
 	updateReq := &client.UpdateMaintenanceWindowRequest{
 		Name:     plan.Name.ValueString(),
@@ -678,16 +717,17 @@ func (r *maintenanceWindowResource) Update(ctx context.Context, req resource.Upd
 	// Update maintenance window
 	_, err = r.client.UpdateMaintenanceWindow(ctx, id, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, nil,
 			"Error updating maintenance window",
 			"Could not update maintenance window, unexpected error: "+err.Error(),
+			err,
 		)
 		return
 	}
 
 	var settled *client.MaintenanceWindow
 	if shouldWait {
-		settled, err = waitMaintenanceWindowSettled(ctx, r.client, id, iv, expectedDays, expectedMonitorIDs, expectedAutoAddMonitors)
+		settled, err = waitMaintenanceWindowSettled(ctx, r.client, id, iv, expectedDays, expectedMonitorIDs, expectedAutoAddMonitors, updateTimeout.Wait(maintenanceWindowSettleTimeout))
 		if err != nil {
 			resp.Diagnostics.AddError("Maintenance window did not settle", err.Error())
 			return
@@ -760,6 +800,7 @@ func waitMaintenanceWindowSettled(
 	expectedDays []int64,
 	expectedMonitorIDs []int64,
 	expectedAutoAddMonitors *bool,
+	timeout time.Duration,
 ) (*client.MaintenanceWindow, error) {
 	want := normalizeDays(expectedDays)
 	wantMonitorIDs := normalizeMonitorIDs(expectedMonitorIDs)
@@ -772,7 +813,9 @@ func waitMaintenanceWindowSettled(
 	const requiredConsecutiveMatches = 3
 	consecutiveMatches := 0
 
-	for attempts := 0; attempts < 20; attempts++ {
+	const pollInterval = 3 * time.Second
+	deadline := time.Now().Add(timeout)
+	for {
 		mw, err := c.GetMaintenanceWindow(ctx, id)
 		if err != nil {
 			return nil, err
@@ -793,10 +836,13 @@ func waitMaintenanceWindowSettled(
 		} else {
 			consecutiveMatches = 0
 		}
+		if time.Now().Add(pollInterval).After(deadline) {
+			break
+		}
 		select {
 		case <-ctx.Done():
 			return lastMW, ctx.Err()
-		case <-time.After(3 * time.Second):
+		case <-time.After(pollInterval):
 		}
 	}
 	wantAutoAdd := "<ignored>"
@@ -825,6 +871,7 @@ func (r *maintenanceWindowResource) stabilizeMaintenanceWindowReadSnapshot(
 	id int64,
 	state maintenanceWindowResourceModel,
 	got *client.MaintenanceWindow,
+	readTimeout optimeout.Operation,
 ) *client.MaintenanceWindow {
 	if got == nil {
 		return got
@@ -858,7 +905,7 @@ func (r *maintenanceWindowResource) stabilizeMaintenanceWindowReadSnapshot(
 		return got
 	}
 
-	settled, err := waitMaintenanceWindowSettled(ctx, r.client, id, wantInterval, wantDays, nil, nil)
+	settled, err := waitMaintenanceWindowSettled(ctx, r.client, id, wantInterval, wantDays, nil, nil, readTimeout.Wait(maintenanceWindowSettleTimeout))
 	if err == nil && settled != nil {
 		return settled
 	}
@@ -1044,16 +1091,25 @@ func (r *maintenanceWindowResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, diags := optimeout.Delete(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := deleteTimeout.Context(ctx)
+	defer cancel()
+
 	err = r.client.DeleteMaintenanceWindow(ctx, id)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		apidiag.AddRequestError(&resp.Diagnostics,
 			"Error deleting maintenance window",
 			"Could not delete maintenance window, unexpected error: "+err.Error(),
+			err,
 		)
 		return
 	}
 
-	err = r.client.WaitMaintenanceWindowDeleted(ctx, id, 2*time.Minute)
+	err = r.client.WaitMaintenanceWindowDeleted(ctx, id, deleteTimeout.Wait(maintenanceWindowDeleteTimeout))
 	if err != nil {
 		resp.Diagnostics.AddError("Timed out waiting for deletion", err.Error())
 		return // if still exists keep in state and it will be auto healed on next read / apply
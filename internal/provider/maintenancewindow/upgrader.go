@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 )
 
 // v0 model where Days was a List.
@@ -86,6 +87,7 @@ func maintenanceWindowUpgradeStateMap() map[int64]resource.StateUpgrader {
 				out.Duration = old.Duration
 				out.AutoAddMonitors = old.AutoAddMonitors
 				out.Status = old.Status
+				out.Timeouts = optimeout.Null()
 
 				// List -> Set
 				if !old.Days.IsNull() && !old.Days.IsUnknown() {
@@ -0,0 +1,122 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+var (
+	_ action.Action              = &monitorAction{}
+	_ action.ActionWithConfigure = &monitorAction{}
+)
+
+// NewResetAction returns the action that resets a monitor's statistics.
+func NewResetAction() action.Action {
+	return &monitorAction{
+		typeName:    "_monitor_reset",
+		description: "Resets the statistics of an UptimeRobot monitor: uptime ratios, response times and the incident log are cleared.",
+		done:        "Reset monitor %d",
+		invoke: func(ctx context.Context, c *client.Client, id int64) error {
+			return c.ResetMonitor(ctx, id)
+		},
+	}
+}
+
+// NewPauseAction returns the action that pauses a monitor.
+func NewPauseAction() action.Action {
+	return &monitorAction{
+		typeName: "_monitor_pause",
+		description: "Pauses an UptimeRobot monitor, for example around a deployment. Leave `is_paused` unset on the monitor " +
+			"resource so the paused state does not show up as drift.",
+		done: "Paused monitor %d",
+		invoke: func(ctx context.Context, c *client.Client, id int64) error {
+			_, err := c.PauseMonitor(ctx, id)
+			return err
+		},
+	}
+}
+
+// NewResumeAction returns the action that resumes a paused monitor.
+func NewResumeAction() action.Action {
+	return &monitorAction{
+		typeName: "_monitor_resume",
+		description: "Resumes a paused UptimeRobot monitor. Leave `is_paused` unset on the monitor resource so the " +
+			"resumed state does not show up as drift.",
+		done: "Resumed monitor %d",
+		invoke: func(ctx context.Context, c *client.Client, id int64) error {
+			_, err := c.StartMonitor(ctx, id)
+			return err
+		},
+	}
+}
+
+// monitorAction runs a single monitor operation. The reset, pause and resume
+// actions only differ in the API call they make.
+type monitorAction struct {
+	client      *client.Client
+	typeName    string
+	description string
+	done        string
+	invoke      func(ctx context.Context, c *client.Client, id int64) error
+}
+
+type monitorActionModel struct {
+	MonitorID types.Int64 `tfsdk:"monitor_id"`
+}
+
+func (a *monitorAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = providerclient.FromActionConfigure(req, resp)
+}
+
+func (a *monitorAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + a.typeName
+}
+
+func (a *monitorAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: a.description,
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.Int64Attribute{
+				Description: "ID of the monitor.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *monitorAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config monitorActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if a.client == nil {
+		resp.Diagnostics.AddError("Missing API Client", "The provider was not configured with an API client.")
+		return
+	}
+
+	id := config.MonitorID.ValueInt64()
+	if err := a.invoke(ctx, a.client, id); err != nil {
+		apidiag.AddRequestError(&resp.Diagnostics,
+			"Error invoking monitor action",
+			fmt.Sprintf("Could not run uptimerobot%s for monitor %d, unexpected error: %s", a.typeName, id, err),
+			err,
+		)
+		return
+	}
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf(a.done, id)})
+	}
+}
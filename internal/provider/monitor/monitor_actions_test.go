@@ -0,0 +1,90 @@
+package monitor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+func TestMonitorActions_Invoke(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		action   func() action.Action
+		typeName string
+		path     string
+		status   int
+		wantErr  bool
+	}{
+		{name: "reset", action: NewResetAction, typeName: "uptimerobot_monitor_reset", path: "/monitors/42/reset", status: http.StatusOK},
+		{name: "pause", action: NewPauseAction, typeName: "uptimerobot_monitor_pause", path: "/monitors/42/pause", status: http.StatusOK},
+		{name: "resume", action: NewResumeAction, typeName: "uptimerobot_monitor_resume", path: "/monitors/42/start", status: http.StatusOK},
+		{name: "api error", action: NewPauseAction, typeName: "uptimerobot_monitor_pause", path: "/monitors/42/pause", status: http.StatusNotFound, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var gotPath string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				gotPath = req.Method + " " + req.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"id":42,"status":"PAUSED"}`))
+			}))
+			defer srv.Close()
+
+			apiClient := client.NewClient("test-key")
+			apiClient.SetBaseURL(srv.URL)
+
+			a := tt.action()
+			var metaResp action.MetadataResponse
+			a.Metadata(ctx, action.MetadataRequest{ProviderTypeName: "uptimerobot"}, &metaResp)
+			if metaResp.TypeName != tt.typeName {
+				t.Fatalf("expected type name %q, got %q", tt.typeName, metaResp.TypeName)
+			}
+			a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: apiClient}, &action.ConfigureResponse{})
+
+			var schemaResp action.SchemaResponse
+			a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"monitor_id": tftypes.NewValue(tftypes.Number, 42),
+				}),
+			}
+
+			var progress []string
+			resp := action.InvokeResponse{
+				SendProgress: func(event action.InvokeProgressEvent) { progress = append(progress, event.Message) },
+			}
+			a.Invoke(ctx, action.InvokeRequest{Config: config}, &resp)
+
+			if gotPath != "POST "+tt.path {
+				t.Fatalf("expected POST %s, got %q", tt.path, gotPath)
+			}
+			if tt.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected an error diagnostic")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if len(progress) != 1 {
+				t.Fatalf("expected one progress message, got %v", progress)
+			}
+		})
+	}
+}
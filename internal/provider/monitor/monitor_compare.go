@@ -4,7 +4,7 @@ import (
 	"bytes"
 	"cmp"
 	"encoding/json"
-	"html"
+	"maps"
 	"slices"
 	"strings"
 
@@ -43,6 +43,9 @@ type monComparable struct {
 	CustomFields         map[string]string
 	MaintenanceWindowIDs []int64
 	skipMWIDsCompare     bool
+	// ignoreCustomFields are the ownership stamp and create token keys,
+	// which the API returns although want does not list them.
+	ignoreCustomFields []string
 	// Config children which we manage
 	SSLExpirationPeriodDays            []int64
 	DNSRecords                         map[string][]string
@@ -891,7 +894,7 @@ func equalComparable(want, got monComparable) bool {
 	if want.Headers != nil && !equalStringMap(want.Headers, got.Headers) {
 		return false
 	}
-	if want.CustomFields != nil && !equalStringMap(want.CustomFields, got.CustomFields) {
+	if want.CustomFields != nil && !equalCustomFields(want, got) {
 		return false
 	}
 	if want.AssignedAlertContacts != nil && !equalAlertContacts(want.AssignedAlertContacts, got.AssignedAlertContacts) {
@@ -969,7 +972,7 @@ func fieldsStillDifferent(want, got monComparable) []string {
 	if want.Headers != nil && !equalStringMap(want.Headers, got.Headers) {
 		f = append(f, "custom_http_headers")
 	}
-	if want.CustomFields != nil && !equalStringMap(want.CustomFields, got.CustomFields) {
+	if want.CustomFields != nil && !equalCustomFields(want, got) {
 		f = append(f, "custom_fields")
 	}
 	if !want.skipMWIDsCompare && want.MaintenanceWindowIDs != nil && !equalInt64Set(want.MaintenanceWindowIDs, got.MaintenanceWindowIDs) {
@@ -1204,6 +1207,22 @@ func equalStringMap(a, b map[string]string) bool {
 	return maputil.EqualStringMap(a, b)
 }
 
+// equalCustomFields compares custom fields, leaving out the ignored keys
+// that want does not list.
+func equalCustomFields(want, got monComparable) bool {
+	gotFields := got.CustomFields
+	for _, key := range want.ignoreCustomFields {
+		if _, listed := want.CustomFields[key]; listed {
+			continue
+		}
+		if _, ok := gotFields[key]; ok {
+			gotFields = maps.Clone(gotFields)
+			delete(gotFields, key)
+		}
+	}
+	return equalStringMap(want.CustomFields, gotFields)
+}
+
 func equalInt64Set(a, b []int64) bool {
 	a = normalizeInt64Set(a)
 	b = normalizeInt64Set(b)
@@ -1265,19 +1284,5 @@ func unescapeHTML(s string) string {
 }
 
 func UnescapeHTML(s string) string {
-	const maxPasses = 5
-
-	if !strings.Contains(s, "&") {
-		return s
-	}
-
-	out := s
-	for i := 0; i < maxPasses; i++ {
-		next := html.UnescapeString(out)
-		if next == out {
-			return out
-		}
-		out = next
-	}
-	return out
+	return client.UnescapeHTML(s)
 }
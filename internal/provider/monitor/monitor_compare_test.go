@@ -90,6 +90,24 @@ func TestEqualComparable_UsesGroupID(t *testing.T) {
 	}
 }
 
+func TestEqualComparable_IgnoresOwnershipStamp(t *testing.T) {
+	t.Parallel()
+
+	want := monComparable{CustomFields: map[string]string{"team": "a"}, ignoreCustomFields: []string{"managed_by"}}
+	got := monComparable{CustomFields: map[string]string{"team": "a", "managed_by": "terraform:prod"}}
+	if !equalComparable(want, got) {
+		t.Fatalf("expected the ownership stamp to be ignored")
+	}
+	if diff := fieldsStillDifferent(want, got); len(diff) != 0 {
+		t.Fatalf("expected no differing fields, got %v", diff)
+	}
+
+	want.CustomFields["managed_by"] = "me"
+	if equalComparable(want, got) {
+		t.Fatalf("expected a configured stamp key to be compared")
+	}
+}
+
 func TestEqualComparable_TreatsPingPortURLsWithoutSchemeAsEquivalent(t *testing.T) {
 	t.Parallel()
 
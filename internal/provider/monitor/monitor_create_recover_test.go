@@ -2,14 +2,17 @@ package monitor
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
 )
 
@@ -343,3 +346,223 @@ func TestRecoverMonitorCreatedDespiteErrorRequiresExactlyOneMatch(t *testing.T)
 		})
 	}
 }
+
+// testPrivate is an in-memory private state.
+type testPrivate map[string][]byte
+
+func (p testPrivate) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivate) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+	} else {
+		p[key] = value
+	}
+	return nil
+}
+
+func TestApplyCreateTokenIsDerivedFromRequest(t *testing.T) {
+	t.Parallel()
+
+	// Two resources stand in for two processes sending the same create.
+	first := &monitorResource{ownership: monitorOwnership{createTokens: true}}
+	second := &monitorResource{ownership: monitorOwnership{createTokens: true}}
+	newReq := func() *client.CreateMonitorRequest {
+		return &client.CreateMonitorRequest{
+			Type: client.MonitorType(MonitorTypeHTTP), Name: "api", URL: "https://example.com", Interval: 300,
+			CustomFields: map[string]string{"managed_by": "terraform:prod"},
+		}
+	}
+	var diags diag.Diagnostics
+	privateA, privateB := testPrivate{}, testPrivate{}
+	a, b := newReq(), newReq()
+	b.HTTPPassword = "secret"
+	tokenA := first.applyCreateToken(context.Background(), a, privateA, &diags)
+	tokenB := second.applyCreateToken(context.Background(), b, privateB, &diags)
+	if diags.HasError() || tokenA == "" || tokenA != tokenB {
+		t.Fatalf("expected the same create to derive the same token, got %q and %q (%v)", tokenA, tokenB, diags)
+	}
+	if a.CustomFields[createTokenField] != tokenA {
+		t.Fatalf("expected the create request to carry the token, got %v", a.CustomFields)
+	}
+	if got, _ := getCreateTokenPrivate(context.Background(), privateA); got != tokenA {
+		t.Fatalf("expected the token in private state, got %q", got)
+	}
+
+	// Another ownership stamp, i.e. another workspace, derives another token.
+	other := newReq()
+	other.CustomFields["managed_by"] = "terraform:staging"
+	if token := first.applyCreateToken(context.Background(), other, testPrivate{}, &diags); token == tokenA {
+		t.Fatalf("expected another stamp to derive another token, got %q", token)
+	}
+}
+
+func TestCreateOrAdoptMonitorAdoptsMonitorLeftByAnotherProcess(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		stored []client.Monitor
+		posts  int
+		// listsOK is how many more listings succeed; -1 means all of them.
+		listsOK = 0
+	)
+	handler := func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/monitors":
+			// The monitor is stored, but the create answers with an error.
+			var body client.CreateMonitorRequest
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			posts++
+			stored = append(stored, client.Monitor{
+				ID: 401, Name: body.Name, Type: string(body.Type), URL: body.URL, CustomFields: body.CustomFields,
+			})
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"message":"internal error"}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/monitors":
+			if listsOK == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message":"listing unavailable"}`))
+				return
+			}
+			if listsOK > 0 {
+				listsOK--
+			}
+			body, _ := json.Marshal(map[string]any{"data": stored})
+			_, _ = w.Write(body)
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.RequestURI())
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+	setListsOK := func(n int) {
+		mu.Lock()
+		defer mu.Unlock()
+		listsOK = n
+	}
+	creates := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return posts
+	}
+	create := func(r *monitorResource) (*client.Monitor, bool, string, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		req := &client.CreateMonitorRequest{Type: client.MonitorType(MonitorTypeHTTP), Name: "api", URL: "https://example.com", Interval: 300}
+		// Every process starts with empty private state, like a create.
+		token := r.applyCreateToken(context.Background(), req, testPrivate{}, &diags)
+		created, adopted := r.createOrAdoptMonitor(context.Background(), nil, req, token, &diags)
+		return created, adopted, token, diags
+	}
+
+	first := newRecoverTestResource(t, handler)
+	first.ownership.createTokens = true
+
+	// A failed lookup before the create is not taken for "no monitor".
+	if created, _, _, diags := create(first); created != nil || !diags.HasError() || creates() != 0 {
+		t.Fatalf("expected the create to stop on the failed lookup, got %#v, %d creates, %v", created, creates(), diags)
+	}
+
+	// The lookup after the failed create fails too, so the monitor is left
+	// behind and the create error says so.
+	setListsOK(1)
+	created, _, token, diags := create(first)
+	if created != nil || creates() != 1 || !strings.Contains(diags[len(diags)-1].Detail(), "listing unavailable") {
+		t.Fatalf("expected one failed create reporting the lookup error, got %#v, %d creates, %v", created, creates(), diags)
+	}
+
+	// Another process derives the same token and adopts the monitor instead
+	// of creating a second one.
+	second := newRecoverTestResource(t, handler)
+	second.ownership.createTokens = true
+	setListsOK(-1)
+	created, adopted, again, diags := create(second)
+	if again != token {
+		t.Fatalf("expected the second process to derive token %q, got %q", token, again)
+	}
+	if created == nil || created.ID != 401 || !adopted || diags.HasError() || creates() != 1 {
+		t.Fatalf("expected monitor 401 to be adopted without another create, got %#v, %d creates, %v", created, creates(), diags)
+	}
+}
+
+func TestFindMonitorByCreateTokenAdoptsFailedCreate(t *testing.T) {
+	t.Parallel()
+
+	const token = "0123456789abcdef"
+	r := newRecoverTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet || req.URL.Path != "/monitors" {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.RequestURI())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !strings.Contains(req.URL.RawQuery, token) {
+			t.Errorf("expected the lookup to filter on the create token, got %q", req.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":301,"friendlyName":"api","type":"HTTP","url":"https://example.com","customFields":{"` + createTokenField + `":"` + token + `"}},
+			{"id":302,"friendlyName":"api","type":"HTTP","url":"https://example.com","customFields":{"` + createTokenField + `":"other"}}
+		],"nextCursorId":null}`))
+	})
+
+	var diags diag.Diagnostics
+	created, err := r.findMonitorByCreateToken(context.Background(), token, &diags)
+	if err != nil || created == nil || created.ID != 301 || diags.HasError() {
+		t.Fatalf("expected monitor 301 to be adopted, got %#v, %v", created, diags)
+	}
+	if fields := r.ownership.unstamped(context.Background(), types.MapNull(types.StringType), nil, created.CustomFields); len(fields) != 0 {
+		t.Fatalf("expected the create token to be hidden, got %v", fields)
+	}
+}
+
+func TestRemoveCreateToken(t *testing.T) {
+	t.Parallel()
+
+	const token = "0123456789abcdef"
+	var patched atomic.Int32
+	r := newRecoverTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/monitors/301" {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.RequestURI())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var body client.UpdateMonitorRequest
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Errorf("decode: %v", err)
+		}
+		if body.CustomFields == nil || len(*body.CustomFields) != 1 || (*body.CustomFields)["team"] != "ops" {
+			t.Errorf("expected the update to keep only the other custom fields, got %v", body.CustomFields)
+		}
+		patched.Add(1)
+		_, _ = w.Write([]byte(`{"id":301,"friendlyName":"api","type":"HTTP","url":"https://example.com","customFields":{"team":"ops"}}`))
+	})
+
+	private := testPrivate{}
+	setCreateTokenPrivate(context.Background(), private, token)
+	m := &client.Monitor{ID: 301, Name: "api", Type: "HTTP", URL: "https://example.com", Interval: 300, CustomFields: map[string]string{
+		createTokenField: token,
+		"team":           "ops",
+	}}
+
+	var diags diag.Diagnostics
+	got := r.removeCreateToken(context.Background(), m, private, &diags)
+	if diags.HasError() || patched.Load() != 1 {
+		t.Fatalf("expected one update, got %d (%v)", patched.Load(), diags)
+	}
+	if _, ok := got.CustomFields[createTokenField]; ok {
+		t.Fatalf("expected the token to be removed, got %v", got.CustomFields)
+	}
+	if _, ok := private[createTokenPrivateKey]; ok {
+		t.Fatal("expected the token to be cleared from private state")
+	}
+
+	// A token the resource did not create is left alone.
+	m.CustomFields[createTokenField] = "other"
+	setCreateTokenPrivate(context.Background(), private, token)
+	r.removeCreateToken(context.Background(), m, private, &diags)
+	if patched.Load() != 1 {
+		t.Fatal("expected no update for a token of another create")
+	}
+}
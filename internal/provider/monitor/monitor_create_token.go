@@ -0,0 +1,180 @@
+package monitor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+// createTokenField is the custom field holding a monitor's create token. Like
+// the ownership stamp it is removed from what the API returns.
+const createTokenField = "tf_create_token"
+
+// createTokenPrivateKey is the private state key of the create token, kept
+// until the token is removed from the monitor. When removing it after the
+// create fails, the next update of the resource tries again.
+const createTokenPrivateKey = "create_token"
+
+type privateData interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// monitorCreateToken returns the create token for req: a hash of the
+// request, so a rerun or another process sending the same create derives the
+// same token and finds the monitor a failed attempt left behind. The request
+// carries the ownership stamp, which keeps the tokens of workspaces with
+// different stamps apart. Credentials, headers and the request body are left
+// out of the hash. It must be computed before the token is added to req.
+func monitorCreateToken(req *client.CreateMonitorRequest) (string, error) {
+	hashed := *req
+	hashed.HTTPUsername = ""
+	hashed.HTTPPassword = ""
+	hashed.CustomHTTPHeaders = nil
+	hashed.PostValueData = nil
+	body, err := json.Marshal(&hashed)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// applyCreateToken adds the create token of req to it when the provider
+// enables create_idempotency_tokens, records it in private and returns it. It
+// returns "" otherwise.
+func (r *monitorResource) applyCreateToken(ctx context.Context, req *client.CreateMonitorRequest, private privateData, diags *diag.Diagnostics) string {
+	if !r.ownership.createTokens {
+		return ""
+	}
+	token, err := monitorCreateToken(req)
+	if err != nil {
+		diags.AddError("Error creating monitor", "Could not compute the create token: "+err.Error())
+		return ""
+	}
+	diags.Append(setCreateTokenPrivate(ctx, private, token)...)
+	fields := maps.Clone(req.CustomFields)
+	if fields == nil {
+		fields = map[string]string{}
+	}
+	fields[createTokenField] = token
+	req.CustomFields = fields
+	return token
+}
+
+func setCreateTokenPrivate(ctx context.Context, private privateData, token string) diag.Diagnostics {
+	if private == nil {
+		return nil
+	}
+	var b []byte
+	if token != "" {
+		b, _ = json.Marshal(token)
+	}
+	return private.SetKey(ctx, createTokenPrivateKey, b)
+}
+
+func getCreateTokenPrivate(ctx context.Context, private privateData) (string, diag.Diagnostics) {
+	if private == nil {
+		return "", nil
+	}
+	b, diags := private.GetKey(ctx, createTokenPrivateKey)
+	if diags.HasError() || len(b) == 0 {
+		return "", diags
+	}
+	var token string
+	if err := json.Unmarshal(b, &token); err != nil {
+		diags.AddError("Error reading monitor", "Could not decode the create token: "+err.Error())
+	}
+	return token, diags
+}
+
+// findMonitorByCreateToken returns the monitor that an earlier or failed
+// attempt of this create stored with token, or nil when there is none. The
+// token is only on a monitor until its create finishes, so a match is adopted
+// whatever the error was. Several matches are an error: the extra monitors
+// have to be removed by hand. A failed listing is returned, so the caller
+// does not take it for "no monitor" and create a duplicate.
+func (r *monitorResource) findMonitorByCreateToken(ctx context.Context, token string, diags *diag.Diagnostics) (*client.Monitor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	monitors, err := r.client.GetMonitorsFiltered(ctx, client.MonitorListFilters{
+		CustomFields: map[string]string{createTokenField: token},
+	})
+	if err != nil {
+		return nil, err
+	}
+	monitors = slices.DeleteFunc(monitors, func(m client.Monitor) bool {
+		return m.CustomFields[createTokenField] != token
+	})
+	switch len(monitors) {
+	case 0:
+		return nil, nil
+	case 1:
+		diags.AddWarning(
+			"Adopting monitor from an earlier create",
+			fmt.Sprintf(
+				"Monitor %d carries create token %s, so an earlier or failed attempt of this create stored it without recording it in state; adopting it instead of creating a duplicate.",
+				monitors[0].ID, token,
+			),
+		)
+		return &monitors[0], nil
+	default:
+		ids := make([]string, 0, len(monitors))
+		for _, m := range monitors {
+			ids = append(ids, strconv.FormatInt(m.ID, 10))
+		}
+		diags.AddError(
+			"Several monitors match the create token",
+			fmt.Sprintf(
+				"Monitors %s all carry create token %s, so none was adopted. Delete the extra monitors, or import one of them, and apply again.",
+				strings.Join(ids, ", "), token,
+			),
+		)
+		return nil, nil
+	}
+}
+
+// removeCreateToken drops the create token from m once the create is
+// recorded, and clears it from private. When that fails the token stays in
+// private, and the next update of the resource tries again.
+func (r *monitorResource) removeCreateToken(ctx context.Context, m *client.Monitor, private privateData, diags *diag.Diagnostics) *client.Monitor {
+	if m == nil {
+		return m
+	}
+	token, ok := m.CustomFields[createTokenField]
+	if !ok {
+		diags.Append(setCreateTokenPrivate(ctx, private, "")...)
+		return m
+	}
+	if pending, d := getCreateTokenPrivate(ctx, private); d.HasError() || pending != token {
+		// Not a token of this resource's create: the configuration or
+		// default_custom_fields set the key themselves.
+		return m
+	}
+
+	fields := maps.Clone(m.CustomFields)
+	delete(fields, createTokenField)
+	req := client.UpdateRequestFromMonitor(m)
+	req.CustomFields = &fields
+	if _, err := r.updateMonitorWithRetry(ctx, m.ID, req); err != nil {
+		diags.AddWarning(
+			"Create token not removed",
+			fmt.Sprintf("Could not remove the create token from monitor %d, so it keeps the hidden %s custom field until the next update of this resource: %s", m.ID, createTokenField, err.Error()),
+		)
+		return m
+	}
+	diags.Append(setCreateTokenPrivate(ctx, private, "")...)
+	out := *m
+	out.CustomFields = fields
+	return &out
+}
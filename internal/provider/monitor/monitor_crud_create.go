@@ -17,9 +17,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apiretry"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
 )
 
+// monitorAPIFieldNames maps monitor request fields whose names differ from the
+// snake_case attribute, so API validation errors point at the right attribute.
+var monitorAPIFieldNames = apidiag.FieldNames{
+	"friendlyName":          "name",
+	"tagNames":              "tags",
+	"maintenanceWindowsIds": "maintenance_window_ids",
+	"sslCheckEnabled":       "check_ssl_errors",
+	"REGION":                "regions",
+	"THRESHOLD":             "thresholds",
+}
+
 var monitorCreateRecoveryBackoffs = []time.Duration{
 	250 * time.Millisecond,
 	500 * time.Millisecond,
@@ -38,22 +52,38 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	writeOnly, diags := monitorWriteOnlyFromConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := optimeout.Create(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := createTimeout.Context(ctx)
+	defer cancel()
+
 	// Build API request from plan
-	createReq, effMethod := r.buildCreateRequest(ctx, plan, resp)
+	createReq, effMethod := r.buildCreateRequest(ctx, r.defaults.requestPlan(writeOnly.requestPlanForCreate(plan)), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token := r.applyCreateToken(ctx, createReq, resp.Private, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Create
-	created, err := r.client.CreateMonitor(ctx, createReq)
-	adopted := false
-	if err != nil {
-		created = r.recoverMonitorCreatedDespiteError(ctx, createReq, err, &resp.Diagnostics)
-		if created == nil {
-			resp.Diagnostics.AddError("Error creating monitor", "Could not create monitor, unexpected error: "+err.Error())
-			return
-		}
-		adopted = true
+	created, adopted := r.createOrAdoptMonitor(ctx, req.Plan.Schema, createReq, token, &resp.Diagnostics)
+	if created == nil {
+		return
+	}
+	if r.account != nil {
+		r.account.MonitorCreated()
 	}
 
 	// Wait to apply in the API
@@ -63,7 +93,7 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 	if strings.ToUpper(plan.Type.ValueString()) == MonitorTypeKEYWORD || want.DNSRecords != nil || want.APIAssertions != nil || want.AssignedAlertContacts != nil || want.RegionData != nil || want.RegionalData != nil {
 		settleTimeout = 180 * time.Second
 	}
-	api, err := r.waitMonitorSettled(ctx, created.ID, want, settleTimeout)
+	api, err := r.waitMonitorSettled(ctx, created.ID, want, createTimeout.Wait(settleTimeout))
 	if err != nil {
 		if adopted {
 			// An adopted monitor's config can't be trusted the way a normal
@@ -73,7 +103,7 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 			resp.Diagnostics.AddError(
 				"Adopted monitor does not match requested configuration",
 				fmt.Sprintf(
-					"Monitor %d was adopted after an ambiguous create error, but it did not settle to match the requested configuration: %s. "+
+					"Monitor %d was adopted instead of created, but it did not settle to match the requested configuration: %s. "+
 						"It was not written to state; inspect the monitor in UptimeRobot (and import it manually once it matches) before re-applying.",
 					created.ID, err.Error(),
 				),
@@ -89,11 +119,12 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 	// Optionally control run state via start/pause endpoints.
 	if !plan.IsPaused.IsNull() && !plan.IsPaused.IsUnknown() {
 		wantPaused := plan.IsPaused.ValueBool()
-		apiAfterStateChange, stateErr := r.ensureMonitorPausedState(ctx, created.ID, wantPaused)
+		apiAfterStateChange, stateErr := r.ensureMonitorPausedState(ctx, created.ID, wantPaused, createTimeout.Wait(pauseSettleTimeout))
 		if stateErr != nil {
-			resp.Diagnostics.AddError(
+			apidiag.AddRequestError(&resp.Diagnostics,
 				"Error setting monitor paused state",
 				"Could not set monitor paused state after create: "+stateErr.Error(),
+				stateErr,
 			)
 			return
 		}
@@ -102,6 +133,10 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
+	if token != "" {
+		api = r.removeCreateToken(ctx, api, resp.Private, &resp.Diagnostics)
+	}
+
 	// Build final state from API response
 	final := r.buildStateAfterCreate(ctx, plan, api, effMethod, resp)
 	if resp.Diagnostics.HasError() {
@@ -109,6 +144,60 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, final)...)
+	if r.registry != nil && !resp.Diagnostics.HasError() {
+		r.registry.Created(providerclient.PlannedMonitor{
+			ID:   plan.ID.ValueString(),
+			Name: unescapeHTML(stringOrEmpty(plan.Name)),
+			Type: strings.ToUpper(plan.Type.ValueString()),
+			URL:  stringOrEmpty(plan.URL),
+		})
+	}
+}
+
+// createOrAdoptMonitor creates the monitor for createReq. With a create
+// token it first adopts a monitor an earlier or failed attempt stored with
+// that token, and after a failed create it looks for the token again before
+// falling back to recoverMonitorCreatedDespiteError. It reports whether the
+// monitor was adopted, and returns nil with an error in diags otherwise.
+func (r *monitorResource) createOrAdoptMonitor(
+	ctx context.Context,
+	schema apidiag.Schema,
+	createReq *client.CreateMonitorRequest,
+	token string,
+	diags *diag.Diagnostics,
+) (*client.Monitor, bool) {
+	existing, err := r.findMonitorByCreateToken(ctx, token, diags)
+	if err != nil {
+		diags.AddError(
+			"Error creating monitor",
+			"Could not look up a monitor stored by an earlier attempt of this create, so the monitor was not created to avoid a duplicate: "+err.Error(),
+		)
+		return nil, false
+	}
+	if existing != nil || diags.HasError() {
+		return existing, existing != nil
+	}
+
+	created, createErr := r.client.CreateMonitor(ctx, createReq)
+	if createErr == nil {
+		return created, false
+	}
+	created, findErr := r.findMonitorByCreateToken(ctx, token, diags)
+	if created == nil && !diags.HasError() {
+		created = r.recoverMonitorCreatedDespiteError(ctx, createReq, createErr, diags)
+	}
+	if created == nil {
+		detail := "Could not create monitor, unexpected error: " + createErr.Error()
+		if findErr != nil {
+			detail += fmt.Sprintf(
+				"\n\nThe monitor may have been stored anyway, but looking it up by its create token %s failed: %s. Apply again to adopt it.",
+				token, findErr.Error(),
+			)
+		}
+		apidiag.AddError(ctx, diags, schema, monitorAPIFieldNames, "Error creating monitor", detail, createErr)
+		return nil, false
+	}
+	return created, true
 }
 
 // recoverMonitorCreatedDespiteError handles create calls that fail with the
@@ -176,8 +265,8 @@ func (r *monitorResource) recoverMonitorCreatedDespiteError(
 }
 
 // findCandidateMonitors looks up monitors that could match createReq by
-// exact name (the API's name filter matches substrings), type, and URL (when
-// the request had one).
+// exact name (the API's name filter matches substrings), type, URL (when
+// the request had one) and create token (when the request had one).
 func (r *monitorResource) findCandidateMonitors(ctx context.Context, createReq *client.CreateMonitorRequest) ([]client.Monitor, error) {
 	monitors, err := r.client.GetMonitorsByName(ctx, createReq.Name)
 	if err != nil {
@@ -186,11 +275,15 @@ func (r *monitorResource) findCandidateMonitors(ctx context.Context, createReq *
 
 	requestedName := unescapeHTML(createReq.Name)
 	requestedURL := unescapeHTML(createReq.URL)
+	token := createReq.CustomFields[createTokenField]
 	var matches []client.Monitor
 	for _, m := range monitors {
 		if unescapeHTML(m.Name) != requestedName {
 			continue
 		}
+		if token != "" && m.CustomFields[createTokenField] != token {
+			continue
+		}
 		if !strings.EqualFold(m.Type, string(createReq.Type)) {
 			continue
 		}
@@ -451,6 +544,7 @@ func (r *monitorResource) buildCreateRequest(
 	r.applyTagsFromPlan(ctx, &plan, req, resp)
 	// Custom fields
 	r.applyCustomFieldsFromPlan(ctx, &plan, req, resp)
+	r.applyOwnershipStamp(req)
 	// Assigned alert contacts
 	r.applyAlertContactsFromPlan(ctx, &plan, req, resp)
 	// Flags ssl/domain/follow/check
@@ -618,6 +712,13 @@ func (r *monitorResource) applyCustomFieldsFromPlan(
 	}
 }
 
+// applyOwnershipStamp adds the provider ownership_stamp to the create request.
+func (r *monitorResource) applyOwnershipStamp(req *client.CreateMonitorRequest) {
+	if fields := r.ownership.stamped(req.CustomFields); len(fields) > 0 {
+		req.CustomFields = fields
+	}
+}
+
 func (r *monitorResource) applyAlertContactsFromPlan(
 	ctx context.Context,
 	plan *monitorResourceModel,
@@ -712,6 +813,9 @@ func (r *monitorResource) buildStateAfterCreate(
 ) monitorResourceModel {
 	plan.Name = types.StringValue(unescapeHTML(api.Name))
 	plan.URL = monitorURLForState(plan.Type.ValueString(), plan.URL, api.URL)
+	if plan.Interval.IsUnknown() {
+		plan.Interval = types.Int64Value(int64(api.Interval))
+	}
 	plan.Status = types.StringValue(api.Status)
 	if !plan.IsPaused.IsNull() && !plan.IsPaused.IsUnknown() {
 		plan.IsPaused = types.BoolValue(isMonitorPausedStatus(api.Status))
@@ -738,11 +842,15 @@ func (r *monitorResource) buildStateAfterCreate(
 	if plan.CustomHTTPHeaders.IsNull() || plan.CustomHTTPHeaders.IsUnknown() {
 		plan.CustomHTTPHeaders = types.MapNull(types.StringType)
 	}
-	customFields, d := customFieldsState(ctx, plan.CustomFields, api.CustomFields, false)
+	apiFields := r.ownership.unstamped(ctx, plan.CustomFields, r.defaults.customFields, api.CustomFields)
+	customFields, d := customFieldsState(ctx, plan.CustomFields, r.defaults.ownCustomFields(ctx, plan.CustomFields, apiFields), false)
 	resp.Diagnostics.Append(d...)
 	if !resp.Diagnostics.HasError() {
 		plan.CustomFields = customFields
 	}
+	customFieldsAll, d := customFieldsAllState(ctx, plan.CustomFieldsAll, apiFields)
+	resp.Diagnostics.Append(d...)
+	plan.CustomFieldsAll = customFieldsAll
 
 	// Method presence in state only for HTTP/KEYWORD/API
 	switch strings.ToUpper(plan.Type.ValueString()) {
@@ -887,8 +995,9 @@ func (r *monitorResource) buildStateAfterCreate(
 	if plan.Tags.IsNull() || plan.Tags.IsUnknown() {
 		plan.Tags = types.SetNull(types.StringType)
 	} else {
-		plan.Tags = tagsSetFromAPI(ctx, api.Tags)
+		plan.Tags = r.defaults.ownTags(ctx, plan.Tags, api.Tags)
 	}
+	plan.TagsAll = tagsAllState(ctx, plan.TagsAll, api.Tags)
 
 	// success_http_response_codes
 	switch {
@@ -7,6 +7,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/deletionprotection"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 )
 
 const deleteWaitTimeout = 2 * time.Minute
@@ -22,6 +25,9 @@ func (r *monitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 	if state.ID.IsNull() || state.ID.IsUnknown() || state.ID.ValueString() == "" {
 		return
 	}
+	if !deletionprotection.CheckDelete(state.DeletionProtection, r.protectAll, "monitor", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
 
 	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
 	if err != nil {
@@ -29,9 +35,17 @@ func (r *monitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := optimeout.Delete(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := deleteTimeout.Context(ctx)
+	defer cancel()
+
 	// Delete and wait. It will treat NotFound as success. Any error here keeps resource in state.
-	if err := r.deleteMonitorAndWait(ctx, id, deleteWaitTimeout); err != nil {
-		resp.Diagnostics.AddError("Timed out or failed deleting monitor", err.Error())
+	if err := r.deleteMonitorAndWait(ctx, id, deleteTimeout.Wait(deleteWaitTimeout)); err != nil {
+		apidiag.AddRequestError(&resp.Diagnostics, "Timed out or failed deleting monitor", err.Error(), err)
 		return
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 )
 
 func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -30,6 +31,14 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := optimeout.Read(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := readTimeout.Context(ctx)
+	defer cancel()
+
 	monitor, err := r.client.GetMonitor(ctx, id)
 	if client.IsNotFound(err) {
 		// Indicates that there is no resource on the server. Remove from state so TF can recreate.
@@ -45,7 +54,7 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	isImport := readIsImport(state)
-	monitor = r.stabilizeMonitorReadSnapshot(ctx, id, state, monitor, isImport)
+	monitor = r.stabilizeMonitorReadSnapshot(ctx, id, state, monitor, isImport, readTimeout)
 
 	state.Type = types.StringValue(monitor.Type)
 	state.Interval = types.Int64Value(int64(monitor.Interval))
@@ -58,7 +67,9 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	readApplyPausedState(&state, monitor, isImport)
 	readApplyRegionalData(ctx, resp, &state, monitor, isImport)
 	readApplyTagsHeadersAC(ctx, resp, &state, monitor, isImport)
-	readApplyCustomFields(ctx, resp, &state, monitor, isImport)
+	fields := r.ownership.unstamped(ctx, state.CustomFields, r.defaults.customFields, monitor.CustomFields)
+	readApplyCustomFields(ctx, resp, &state, r.defaults.ownCustomFields(ctx, state.CustomFields, fields), isImport)
+	r.defaults.readApply(ctx, resp, &state, monitor, fields, isImport)
 	readApplySuccessCodes(ctx, resp, &state, monitor)
 	readApplyBooleans(&state, monitor, isImport)
 	readApplyMWIDs(ctx, resp, &state, monitor)
@@ -68,6 +79,10 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	resp.Diagnostics.Append(diags...)
 }
 
+// readSettleTimeout is how long Read waits for a lagging snapshot to catch up
+// with state when no timeout is configured.
+const readSettleTimeout = 60 * time.Second
+
 // Helpers
 
 func readIsImport(s monitorResourceModel) bool {
@@ -306,8 +321,11 @@ func readApplyTagsHeadersAC(ctx context.Context, resp *resource.ReadResponse, st
 	} else if state.CustomHTTPHeaders.IsNull() || state.CustomHTTPHeaders.IsUnknown() {
 		// Keeping cleared and unmanaged headers as null on normal reads.
 		// This avoids stale API replicas repopulating headers right after clear.
+		// Headers managed through custom_http_headers_wo are echoed by the API
+		// but must never reach state either.
 		state.CustomHTTPHeaders = types.MapNull(types.StringType)
 	}
+	state.CustomHTTPHeadersWO = types.MapNull(types.StringType)
 
 	acSet, d := alertContactsFromAPI(ctx, m.AssignedAlertContacts)
 	resp.Diagnostics.Append(d...)
@@ -325,8 +343,8 @@ func readApplyTagsHeadersAC(ctx context.Context, resp *resource.ReadResponse, st
 	}
 }
 
-func readApplyCustomFields(ctx context.Context, resp *resource.ReadResponse, state *monitorResourceModel, m *client.Monitor, isImport bool) {
-	customFields, d := customFieldsState(ctx, state.CustomFields, m.CustomFields, isImport)
+func readApplyCustomFields(ctx context.Context, resp *resource.ReadResponse, state *monitorResourceModel, api map[string]string, isImport bool) {
+	customFields, d := customFieldsState(ctx, state.CustomFields, api, isImport)
 	resp.Diagnostics.Append(d...)
 	if !resp.Diagnostics.HasError() {
 		state.CustomFields = customFields
@@ -339,6 +357,7 @@ func (r *monitorResource) stabilizeMonitorReadSnapshot(
 	state monitorResourceModel,
 	monitor *client.Monitor,
 	isImport bool,
+	readTimeout optimeout.Operation,
 ) *client.Monitor {
 	if isImport || monitor == nil {
 		return monitor
@@ -350,14 +369,15 @@ func (r *monitorResource) stabilizeMonitorReadSnapshot(
 		expectedPaused = &v
 	}
 	if expectedPaused != nil && isMonitorPausedStatus(monitor.Status) != *expectedPaused {
-		if settled, err := r.waitMonitorPauseState(ctx, id, *expectedPaused, 60*time.Second); err == nil && settled != nil {
+		if settled, err := r.waitMonitorPauseState(ctx, id, *expectedPaused, readTimeout.Wait(readSettleTimeout)); err == nil && settled != nil {
 			monitor = settled
 		} else if settled != nil && isMonitorPausedStatus(settled.Status) == *expectedPaused {
 			monitor = settled
 		}
 	}
 
-	want := monitorReadStabilizationWant(ctx, state)
+	want := monitorReadStabilizationWant(ctx, r.defaults.requestPlan(state))
+	want.ignoreCustomFields = r.ownership.hiddenCustomFields()
 	if !hasMonitorReadStabilizationAssertions(want) {
 		return monitor
 	}
@@ -367,7 +387,7 @@ func (r *monitorResource) stabilizeMonitorReadSnapshot(
 		return monitor
 	}
 
-	if settled, err := r.waitMonitorSettled(ctx, id, want, 60*time.Second); err == nil && settled != nil {
+	if settled, err := r.waitMonitorSettled(ctx, id, want, readTimeout.Wait(readSettleTimeout)); err == nil && settled != nil {
 		return settled
 	} else if settled != nil {
 		return settled
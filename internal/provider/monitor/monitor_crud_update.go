@@ -16,7 +16,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apiretry"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 )
 
 func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -55,10 +57,25 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	updateReq, effMethod := buildUpdateRequest(ctx, plan, state, configOmitted, applicationErrorRetriesOmitted, httpMethodTypeOmitted, resp)
+	writeOnly, diags := monitorWriteOnlyFromConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	updateTimeout, diags := optimeout.Update(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := updateTimeout.Context(ctx)
+	defer cancel()
+
+	updateReq, effMethod := buildUpdateRequest(ctx, r.defaults.requestPlan(writeOnly.requestPlanForUpdate(plan, state)), state, configOmitted, applicationErrorRetriesOmitted, httpMethodTypeOmitted, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.ownership.stampUpdate(ctx, updateReq, plan.CustomFieldsAll)
 	configSent := updateReq.Config != nil
 
 	clearRegionThresholds, d := shouldClearRegionDataThresholds(ctx, plan, state)
@@ -69,14 +86,14 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 	if clearRegionThresholds {
 		clearReq := cloneUpdateRequestForRegionThresholdClear(updateReq)
 		if _, err := r.updateMonitorWithRetry(ctx, id, clearReq); err != nil {
-			resp.Diagnostics.AddError("Error clearing regional thresholds", "Could not clear monitor regional thresholds before update: "+err.Error())
+			apidiag.AddRequestError(&resp.Diagnostics, "Error clearing regional thresholds", "Could not clear monitor regional thresholds before update: "+err.Error(), err)
 			return
 		}
 	}
 
 	initialUpdated, err := r.updateMonitorWithRetry(ctx, id, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating monitor", "Could not update monitor: "+err.Error())
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, monitorAPIFieldNames, "Error updating monitor", "Could not update monitor: "+err.Error(), err)
 		return
 	}
 
@@ -101,7 +118,7 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 	needSettle := true
 
 	if needSettle {
-		if updated, err = r.waitMonitorSettled(ctx, id, settleWant, settleTimeout); err != nil {
+		if updated, err = r.waitMonitorSettled(ctx, id, settleWant, updateTimeout.Wait(settleTimeout)); err != nil {
 			if updated != nil {
 				got = buildComparableFromAPI(updated)
 			}
@@ -116,11 +133,12 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 	if !plan.IsPaused.IsNull() && !plan.IsPaused.IsUnknown() {
 		wantPaused := plan.IsPaused.ValueBool()
 		if updated == nil || isMonitorPausedStatus(updated.Status) != wantPaused {
-			updatedAfterStateChange, stateErr := r.ensureMonitorPausedState(ctx, id, wantPaused)
+			updatedAfterStateChange, stateErr := r.ensureMonitorPausedState(ctx, id, wantPaused, updateTimeout.Wait(pauseSettleTimeout))
 			if stateErr != nil {
-				resp.Diagnostics.AddError(
+				apidiag.AddRequestError(&resp.Diagnostics,
 					"Error setting monitor paused state",
 					"Could not set monitor paused state after update: "+stateErr.Error(),
+					stateErr,
 				)
 				return
 			}
@@ -130,8 +148,11 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 	updated = applyTrustedMonitorUpdateEcho(updated, initialUpdated, updateReq, trustedEcho)
+	if token, diags := getCreateTokenPrivate(ctx, resp.Private); token != "" && !diags.HasError() {
+		updated = r.removeCreateToken(ctx, updated, resp.Private, &resp.Diagnostics)
+	}
 
-	newState := applyUpdatedMonitorToState(ctx, plan, state, updated, effMethod, configSent, resp)
+	newState := applyUpdatedMonitorToState(ctx, r.defaults, r.ownership, plan, state, updated, effMethod, configSent, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -731,6 +752,8 @@ func setAlertContactsOnUpdate(
 
 func applyUpdatedMonitorToState(
 	ctx context.Context,
+	defaults monitorDefaultTags,
+	ownership monitorOwnership,
 	plan monitorResourceModel,
 	prev monitorResourceModel,
 	m *client.Monitor,
@@ -741,6 +764,9 @@ func applyUpdatedMonitorToState(
 	out := plan
 	out.Name = types.StringValue(unescapeHTML(m.Name))
 	out.URL = monitorURLForState(plan.Type.ValueString(), plan.URL, m.URL)
+	if plan.Interval.IsUnknown() {
+		out.Interval = types.Int64Value(int64(m.Interval))
+	}
 	out.Status = prev.Status
 	if !plan.IsPaused.IsNull() && !plan.IsPaused.IsUnknown() {
 		out.IsPaused = types.BoolValue(isMonitorPausedStatus(m.Status))
@@ -837,8 +863,9 @@ func applyUpdatedMonitorToState(
 	if plan.Tags.IsNull() || plan.Tags.IsUnknown() {
 		out.Tags = types.SetNull(types.StringType)
 	} else {
-		out.Tags = tagsSetFromAPI(ctx, m.Tags)
+		out.Tags = defaults.ownTags(ctx, plan.Tags, m.Tags)
 	}
+	out.TagsAll = tagsAllState(ctx, plan.TagsAll, m.Tags)
 
 	// headers. Keep user’s shape
 	if plan.CustomHTTPHeaders.IsNull() || plan.CustomHTTPHeaders.IsUnknown() {
@@ -846,11 +873,15 @@ func applyUpdatedMonitorToState(
 	} else {
 		out.CustomHTTPHeaders = plan.CustomHTTPHeaders
 	}
-	customFields, d := customFieldsState(ctx, plan.CustomFields, m.CustomFields, false)
+	apiFields := ownership.unstamped(ctx, plan.CustomFields, defaults.customFields, m.CustomFields)
+	customFields, d := customFieldsState(ctx, plan.CustomFields, defaults.ownCustomFields(ctx, plan.CustomFields, apiFields), false)
 	resp.Diagnostics.Append(d...)
 	if !resp.Diagnostics.HasError() {
 		out.CustomFields = customFields
 	}
+	customFieldsAll, d := customFieldsAllState(ctx, plan.CustomFieldsAll, apiFields)
+	resp.Diagnostics.Append(d...)
+	out.CustomFieldsAll = customFieldsAll
 
 	// Maintenance windows
 	{
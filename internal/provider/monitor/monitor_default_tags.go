@@ -0,0 +1,217 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+// monitorDefaultTags holds the provider default_tags and default_custom_fields.
+// They are merged into tags_all and custom_fields_all, which are what the API
+// receives, and subtracted again from what the API returns so that tags and
+// custom_fields only hold the resource's own values.
+type monitorDefaultTags struct {
+	tags         []string
+	customFields map[string]string
+}
+
+// tagsManaged reports whether the API tags are managed: by the resource's own
+// tags or by default_tags.
+func (d monitorDefaultTags) tagsManaged(tags types.Set) bool {
+	return (!tags.IsNull() && !tags.IsUnknown()) || len(d.tags) > 0
+}
+
+func (d monitorDefaultTags) customFieldsManaged(fields types.Map) bool {
+	return (!fields.IsNull() && !fields.IsUnknown()) || len(d.customFields) > 0
+}
+
+// mergeTags returns the resource tags plus default_tags.
+func (d monitorDefaultTags) mergeTags(ctx context.Context, tags types.Set) ([]string, diag.Diagnostics) {
+	var own []string
+	var diags diag.Diagnostics
+	if !tags.IsNull() && !tags.IsUnknown() {
+		diags = tags.ElementsAs(ctx, &own, false)
+	}
+	return normalizeTagSet(append(own, d.tags...)), diags
+}
+
+// mergeCustomFields returns default_custom_fields overridden by the resource
+// custom fields.
+func (d monitorDefaultTags) mergeCustomFields(ctx context.Context, fields types.Map) (map[string]string, diag.Diagnostics) {
+	merged := maps.Clone(d.customFields)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	own, diags := stringMapFromAttrPreserveEmpty(ctx, fields)
+	maps.Copy(merged, own)
+	return merged, diags
+}
+
+// modifyPlan plans tags_all and custom_fields_all. While neither the resource
+// nor the provider manages a value, the prior state is kept. reserved returns
+// the custom fields the provider adds on top of the planned ones.
+func (d monitorDefaultTags) modifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, reserved func(map[string]string) int) {
+	var configTags types.Set
+	var configFields types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &configTags)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("custom_fields"), &configFields)...)
+
+	stateTagsAll := types.SetUnknown(types.StringType)
+	stateFieldsAll := types.MapUnknown(types.StringType)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags_all"), &stateTagsAll)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("custom_fields_all"), &stateFieldsAll)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll := stateTagsAll
+	switch {
+	case configTags.IsUnknown():
+		tagsAll = types.SetUnknown(types.StringType)
+	case d.tagsManaged(configTags):
+		merged, diags := d.mergeTags(ctx, configTags)
+		resp.Diagnostics.Append(diags...)
+		tagsAll = stringSetValue(merged)
+	}
+
+	fieldsAll := stateFieldsAll
+	switch {
+	case configFields.IsUnknown():
+		fieldsAll = types.MapUnknown(types.StringType)
+	case d.customFieldsManaged(configFields):
+		merged, diags := d.mergeCustomFields(ctx, configFields)
+		resp.Diagnostics.Append(diags...)
+		if n := len(merged) + reserved(merged); n > customFieldsMaxKeys {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom_fields"),
+				"Too Many Custom Fields",
+				fmt.Sprintf("custom_fields and the provider default_custom_fields, ownership_stamp and create token add up to %d keys; at most %d are allowed.", n, customFieldsMaxKeys),
+			)
+			return
+		}
+		fieldsAll, diags = types.MapValueFrom(ctx, types.StringType, merged)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("custom_fields_all"), fieldsAll)...)
+}
+
+// requestPlan returns plan with tags_all and custom_fields_all in place of
+// tags and custom_fields when they are managed, so requests and the settle
+// comparison cover the defaults too.
+func (d monitorDefaultTags) requestPlan(plan monitorResourceModel) monitorResourceModel {
+	if d.tagsManaged(plan.Tags) && !plan.TagsAll.IsNull() && !plan.TagsAll.IsUnknown() {
+		plan.Tags = plan.TagsAll
+	}
+	if d.customFieldsManaged(plan.CustomFields) && !plan.CustomFieldsAll.IsNull() && !plan.CustomFieldsAll.IsUnknown() {
+		plan.CustomFields = plan.CustomFieldsAll
+	}
+	return plan
+}
+
+// readApply refreshes tags_all and custom_fields_all from the API, with
+// fields the API custom fields without the hidden ones. Tags are not
+// refreshed on normal reads, so tags_all is only read on import or when
+// state has none yet; on import the default tags are left out of tags.
+func (d monitorDefaultTags) readApply(ctx context.Context, resp *resource.ReadResponse, state *monitorResourceModel, m *client.Monitor, fields map[string]string, isImport bool) {
+	if isImport && len(d.tags) > 0 {
+		state.Tags = d.ownTags(ctx, types.SetNull(types.StringType), m.Tags)
+		if len(state.Tags.Elements()) == 0 {
+			state.Tags = types.SetNull(types.StringType)
+		}
+	}
+	if isImport || state.TagsAll.IsNull() || state.TagsAll.IsUnknown() {
+		state.TagsAll = tagsSetFromAPI(ctx, m.Tags)
+	}
+
+	fieldsAll, diags := customFieldsAllState(ctx, types.MapUnknown(types.StringType), fields)
+	resp.Diagnostics.Append(diags...)
+	state.CustomFieldsAll = fieldsAll
+}
+
+// ownTags drops default tags the resource does not list itself from the API
+// tags.
+func (d monitorDefaultTags) ownTags(ctx context.Context, tags types.Set, api []client.Tag) types.Set {
+	all := tagsSetFromAPI(ctx, api)
+	if len(d.tags) == 0 {
+		return all
+	}
+	var own []string
+	if !tags.IsNull() && !tags.IsUnknown() {
+		_ = tags.ElementsAs(ctx, &own, false)
+	}
+	own = normalizeTagSet(own)
+	vals := make([]attr.Value, 0, len(all.Elements()))
+	for _, v := range all.Elements() {
+		s := v.(types.String).ValueString()
+		if slices.Contains(d.tags, s) && !slices.Contains(own, s) {
+			continue
+		}
+		vals = append(vals, v)
+	}
+	return types.SetValueMust(types.StringType, vals)
+}
+
+// ownCustomFields drops default custom fields the resource does not set
+// itself from the API custom fields, which must already be unstamped. A
+// default key whose value was changed outside Terraform is kept so it shows
+// up as drift.
+func (d monitorDefaultTags) ownCustomFields(ctx context.Context, fields types.Map, api map[string]string) map[string]string {
+	if len(d.customFields) == 0 || api == nil {
+		return api
+	}
+	own, _ := stringMapFromAttrPreserveEmpty(ctx, fields)
+	out := make(map[string]string, len(api))
+	for k, v := range api {
+		if dv, ok := d.customFields[k]; ok && dv == v {
+			if _, set := own[k]; !set {
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// tagsAllState returns the planned tags_all, or the API tags when it was
+// planned as unknown.
+func tagsAllState(ctx context.Context, planned types.Set, api []client.Tag) types.Set {
+	if !planned.IsUnknown() {
+		return planned
+	}
+	return tagsSetFromAPI(ctx, api)
+}
+
+// customFieldsAllState returns the planned custom_fields_all, or the API
+// custom fields when it was planned as unknown.
+func customFieldsAllState(ctx context.Context, planned types.Map, api map[string]string) (types.Map, diag.Diagnostics) {
+	if !planned.IsUnknown() {
+		return planned, nil
+	}
+	if api == nil {
+		api = map[string]string{}
+	}
+	return types.MapValueFrom(ctx, types.StringType, api)
+}
+
+func stringSetValue(values []string) types.Set {
+	vals := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		vals = append(vals, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, vals)
+}
@@ -0,0 +1,119 @@
+package monitor
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+func setStrings(t *testing.T, s types.Set) []string {
+	t.Helper()
+	var out []string
+	if diags := s.ElementsAs(context.Background(), &out, false); diags.HasError() {
+		t.Fatalf("reading set: %v", diags)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestMonitorDefaultTags_MergeTags(t *testing.T) {
+	t.Parallel()
+
+	d := monitorDefaultTags{tags: []string{"env-prod", "team-a"}}
+	got, diags := d.mergeTags(context.Background(), stringSetValue([]string{"api", "team-a"}))
+	if diags.HasError() {
+		t.Fatalf("mergeTags: %v", diags)
+	}
+	if want := []string{"api", "env-prod", "team-a"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	got, _ = d.mergeTags(context.Background(), types.SetNull(types.StringType))
+	if want := []string{"env-prod", "team-a"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected defaults only, got %v", got)
+	}
+}
+
+func TestMonitorDefaultTags_MergeCustomFieldsResourceWins(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := monitorDefaultTags{customFields: map[string]string{"owner": "platform", "tier": "1"}}
+	own, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"tier": "2", "service": "api"})
+
+	got, diags := d.mergeCustomFields(ctx, own)
+	if diags.HasError() {
+		t.Fatalf("mergeCustomFields: %v", diags)
+	}
+	want := map[string]string{"owner": "platform", "tier": "2", "service": "api"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if d.customFields["tier"] != "1" {
+		t.Fatal("defaults must not be modified")
+	}
+}
+
+func TestMonitorDefaultTags_OwnTagsDropsDefaults(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := monitorDefaultTags{tags: []string{"env-prod", "team-a"}}
+	api := []client.Tag{{Name: "api"}, {Name: "env-prod"}, {Name: "team-a"}}
+
+	got := setStrings(t, d.ownTags(ctx, stringSetValue([]string{"api", "team-a"}), api))
+	if want := []string{"api", "team-a"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	got = setStrings(t, monitorDefaultTags{}.ownTags(ctx, stringSetValue([]string{"api"}), api))
+	if want := []string{"api", "env-prod", "team-a"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected all API tags without defaults, got %v", got)
+	}
+}
+
+func TestMonitorDefaultTags_OwnCustomFieldsKeepsChangedDefaults(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := monitorDefaultTags{customFields: map[string]string{"owner": "platform", "tier": "1"}}
+	own, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"service": "api"})
+
+	got := d.ownCustomFields(ctx, own, map[string]string{
+		"service": "api",
+		"owner":   "platform",
+		"tier":    "3",
+	})
+	want := map[string]string{"service": "api", "tier": "3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestMonitorDefaultTags_RequestPlanUsesAllValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	all, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"owner": "platform"})
+	plan := monitorResourceModel{
+		Tags:            types.SetNull(types.StringType),
+		TagsAll:         stringSetValue([]string{"env-prod"}),
+		CustomFields:    types.MapNull(types.StringType),
+		CustomFieldsAll: all,
+	}
+
+	got := monitorDefaultTags{}.requestPlan(plan)
+	if !got.Tags.IsNull() || !got.CustomFields.IsNull() {
+		t.Fatalf("expected unmanaged values to stay null without defaults, got %v %v", got.Tags, got.CustomFields)
+	}
+
+	d := monitorDefaultTags{tags: []string{"env-prod"}, customFields: map[string]string{"owner": "platform"}}
+	got = d.requestPlan(plan)
+	if !got.Tags.Equal(plan.TagsAll) || !got.CustomFields.Equal(all) {
+		t.Fatalf("expected tags_all and custom_fields_all in the request, got %v %v", got.Tags, got.CustomFields)
+	}
+}
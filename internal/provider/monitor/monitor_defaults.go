@@ -0,0 +1,35 @@
+package monitor
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// monitorDefaultsTF is the provider monitor_defaults block. Its values are
+// used for monitor attributes the configuration leaves null; see
+// applyMonitorDefaults.
+type monitorDefaultsTF struct {
+	Interval              types.Int64  `tfsdk:"interval"`
+	Timeout               types.Int64  `tfsdk:"timeout"`
+	AssignedAlertContacts types.Set    `tfsdk:"assigned_alert_contacts"`
+	SSLExpirationReminder types.Bool   `tfsdk:"ssl_expiration_reminder"`
+	FollowRedirections    types.Bool   `tfsdk:"follow_redirections"`
+	RegionData            types.Object `tfsdk:"region_data"`
+}
+
+// monitorDefaultsFromProvider decodes the monitor_defaults block. It returns
+// nil when the block is not set.
+func monitorDefaultsFromProvider(ctx context.Context, block types.Object) (*monitorDefaultsTF, diag.Diagnostics) {
+	if block.IsNull() || block.IsUnknown() {
+		return nil, nil
+	}
+	var defaults monitorDefaultsTF
+	diags := block.As(ctx, &defaults, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &defaults, diags
+}
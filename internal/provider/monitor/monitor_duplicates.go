@@ -0,0 +1,95 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+// Values of the provider duplicate_monitor_check attribute.
+const (
+	duplicateCheckWarning = "warning"
+	duplicateCheckError   = "error"
+)
+
+// checkDuplicates reports monitors of the same type and URL as the planned
+// one: other monitors planned before it, recorded in the provider-shared
+// registry, and monitors that already exist in the account. Only creates and
+// type or URL changes are reported, so an existing duplicate does not fail
+// every plan. Monitors without a URL, such as heartbeats, are skipped. When
+// the account cannot be listed, a warning says that only the plan was
+// checked.
+func (r *monitorResource) checkDuplicates(ctx context.Context, creating bool, monitorType string, plan, state monitorResourceModel, diags *diag.Diagnostics) {
+	if r.duplicateCheck == "" || r.registry == nil || monitorType == "" {
+		return
+	}
+	if plan.URL.IsNull() || plan.URL.IsUnknown() || strings.TrimSpace(plan.URL.ValueString()) == "" {
+		return
+	}
+	self := providerclient.PlannedMonitor{
+		ID:   stringOrEmpty(state.ID),
+		Name: unescapeHTML(stringOrEmpty(plan.Name)),
+		Type: monitorType,
+		URL:  plan.URL.ValueString(),
+	}
+	planned := r.registry.Plan(self)
+	changed := !strings.EqualFold(stringOrEmpty(state.Type), monitorType) || !monitorURLsEquivalentForState(monitorType, self.URL, stringOrEmpty(state.URL))
+	if !creating && !changed {
+		return
+	}
+
+	var matches []string
+	seen := map[string]bool{}
+	for _, p := range planned {
+		if !sameMonitorTarget(self, p.Type, p.URL) {
+			continue
+		}
+		if p.ID != "" {
+			seen[p.ID] = true
+			matches = append(matches, fmt.Sprintf("%q (id %s, in this plan)", p.Name, p.ID))
+			continue
+		}
+		matches = append(matches, fmt.Sprintf("%q (created in this plan)", p.Name))
+	}
+	existing, err := r.registry.Existing(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "skipping the account duplicate check, monitors could not be listed", map[string]any{"error": err.Error()})
+		diags.AddAttributeWarning(path.Root("url"),
+			"Duplicate monitor check incomplete",
+			"The monitors in the account could not be listed, so this monitor was only checked against the other monitors "+
+				"in this plan: "+err.Error(),
+		)
+	}
+	for _, m := range existing {
+		id := strconv.FormatInt(m.ID, 10)
+		if id == self.ID || seen[id] || !sameMonitorTarget(self, m.Type, unescapeHTML(m.URL)) {
+			continue
+		}
+		matches = append(matches, fmt.Sprintf("%q (id %s)", unescapeHTML(m.Name), id))
+	}
+	if len(matches) == 0 {
+		return
+	}
+
+	summary := "Duplicate monitor"
+	detail := fmt.Sprintf(
+		"Another %s monitor already checks %s: %s. Duplicate monitors alert twice for the same outage. "+
+			"If the other monitor is destroyed in the same run, for example when a resource moved without a moved block, this can be ignored.",
+		monitorType, self.URL, strings.Join(matches, ", "),
+	)
+	if r.duplicateCheck == duplicateCheckError {
+		diags.AddAttributeError(path.Root("url"), summary, detail)
+		return
+	}
+	diags.AddAttributeWarning(path.Root("url"), summary, detail)
+}
+
+func sameMonitorTarget(self providerclient.PlannedMonitor, monitorType, url string) bool {
+	return strings.EqualFold(self.Type, monitorType) && monitorURLsEquivalentForState(self.Type, self.URL, url)
+}
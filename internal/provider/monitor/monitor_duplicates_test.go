@@ -0,0 +1,143 @@
+package monitor
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+func duplicateTestPlan(name, url string) monitorResourceModel {
+	return monitorResourceModel{
+		Name: types.StringValue(name),
+		Type: types.StringValue(MonitorTypeHTTP),
+		URL:  types.StringValue(url),
+	}
+}
+
+func TestCheckDuplicates(t *testing.T) {
+	t.Parallel()
+
+	r := newRecoverTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":7,"friendlyName":"existing","type":"HTTP","url":"https://api.example.com/health"}
+		],"nextCursorId":null}`))
+	})
+	r.registry = providerclient.NewMonitorRegistry(r.client)
+	r.duplicateCheck = duplicateCheckWarning
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	r.checkDuplicates(ctx, true, MonitorTypeHTTP, duplicateTestPlan("a", "https://example.com"), monitorResourceModel{}, &diags)
+	if diags.WarningsCount() != 0 {
+		t.Fatalf("expected no duplicate for the first monitor, got %v", diags)
+	}
+
+	r.checkDuplicates(ctx, true, MonitorTypeHTTP, duplicateTestPlan("b", "https://example.com"), monitorResourceModel{}, &diags)
+	if diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), `"a" (created in this plan)`) {
+		t.Fatalf("expected a duplicate of the planned monitor, got %v", diags)
+	}
+
+	diags = nil
+	r.duplicateCheck = duplicateCheckError
+	r.checkDuplicates(ctx, true, MonitorTypeHTTP, duplicateTestPlan("c", "https://api.example.com/health"), monitorResourceModel{}, &diags)
+	if diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Detail(), `"existing" (id 7)`) {
+		t.Fatalf("expected a duplicate of the account monitor, got %v", diags)
+	}
+
+	// The existing monitor itself is unchanged and not reported.
+	diags = nil
+	state := duplicateTestPlan("existing", "https://api.example.com/health")
+	state.ID = types.StringValue("7")
+	r.checkDuplicates(ctx, false, MonitorTypeHTTP, state, state, &diags)
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("expected no report for an unchanged monitor, got %v", diags)
+	}
+}
+
+func TestCheckDuplicates_RetriesFailedListing(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	r := newRecoverTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"bad request"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":7,"friendlyName":"existing","type":"HTTP","url":"https://example.com"}
+		],"nextCursorId":null}`))
+	})
+	r.registry = providerclient.NewMonitorRegistry(r.client)
+	r.duplicateCheck = duplicateCheckWarning
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	r.checkDuplicates(ctx, true, MonitorTypeHTTP, duplicateTestPlan("a", "https://other.example.com"), monitorResourceModel{}, &diags)
+	if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Duplicate monitor check incomplete" {
+		t.Fatalf("expected a warning that the account could not be listed, got %v", diags)
+	}
+
+	diags = nil
+	r.duplicateCheck = duplicateCheckError
+	r.checkDuplicates(ctx, true, MonitorTypeHTTP, duplicateTestPlan("b", "https://example.com"), monitorResourceModel{}, &diags)
+	if diags.WarningsCount() != 0 || diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Detail(), `"existing" (id 7)`) {
+		t.Fatalf("expected the listing to be retried, got %v", diags)
+	}
+}
+
+func TestCheckDuplicates_CreatedMonitorReportedOnce(t *testing.T) {
+	t.Parallel()
+
+	r := newRecoverTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":7,"friendlyName":"a","type":"HTTP","url":"https://example.com"}
+		],"nextCursorId":null}`))
+	})
+	r.registry = providerclient.NewMonitorRegistry(r.client)
+	r.duplicateCheck = duplicateCheckWarning
+	ctx := context.Background()
+
+	// "a" is planned, then created earlier in the same apply as monitor 7,
+	// which the account listing also returns.
+	r.registry.Plan(providerclient.PlannedMonitor{Name: "a", Type: MonitorTypeHTTP, URL: "https://example.com"})
+	r.registry.Created(providerclient.PlannedMonitor{ID: "7", Name: "a", Type: MonitorTypeHTTP, URL: "https://example.com"})
+
+	var diags diag.Diagnostics
+	r.checkDuplicates(ctx, true, MonitorTypeHTTP, duplicateTestPlan("b", "https://example.com"), monitorResourceModel{}, &diags)
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected one warning, got %v", diags)
+	}
+	if detail := diags.Warnings()[0].Detail(); strings.Count(detail, `"a"`) != 1 || !strings.Contains(detail, "id 7") {
+		t.Fatalf("expected monitor 7 to be reported once, got %s", detail)
+	}
+}
+
+func TestCheckDuplicates_ReportsEachPairWhateverThePlanOrder(t *testing.T) {
+	t.Parallel()
+
+	for _, order := range [][]string{{"a", "b"}, {"b", "a"}} {
+		r := newRecoverTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+			_, _ = w.Write([]byte(`{"data":[],"nextCursorId":null}`))
+		})
+		r.registry = providerclient.NewMonitorRegistry(r.client)
+		r.duplicateCheck = duplicateCheckWarning
+
+		// Two creates with the same name are still two monitors.
+		var reports int
+		for _, name := range append(order, "a") {
+			var diags diag.Diagnostics
+			r.checkDuplicates(context.Background(), true, MonitorTypeHTTP, duplicateTestPlan(name, "https://example.com"), monitorResourceModel{}, &diags)
+			reports += diags.WarningsCount()
+		}
+		if reports != 2 {
+			t.Fatalf("order %v: expected the second and third monitor to report duplicates, got %d reports", order, reports)
+		}
+	}
+}
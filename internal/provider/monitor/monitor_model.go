@@ -2,6 +2,7 @@ package monitor
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -18,6 +19,7 @@ type monitorResourceModel struct {
 	HTTPPassword             types.String         `tfsdk:"http_password"`
 	CustomHTTPHeaders        types.Map            `tfsdk:"custom_http_headers"`
 	CustomFields             types.Map            `tfsdk:"custom_fields"`
+	CustomFieldsAll          types.Map            `tfsdk:"custom_fields_all"`
 	HTTPMethodType           types.String         `tfsdk:"http_method_type"`
 	SuccessHTTPResponseCodes types.Set            `tfsdk:"success_http_response_codes"`
 	Timeout                  types.Int64          `tfsdk:"timeout"`
@@ -31,18 +33,29 @@ type monitorResourceModel struct {
 	KeywordType              types.String         `tfsdk:"keyword_type"`
 	MaintenanceWindowIDs     types.Set            `tfsdk:"maintenance_window_ids"`
 	ID                       types.String         `tfsdk:"id"`
+	DeletionProtection       types.Bool           `tfsdk:"deletion_protection"`
 	Name                     types.String         `tfsdk:"name"`
 	IsPaused                 types.Bool           `tfsdk:"is_paused"`
 	Status                   types.String         `tfsdk:"status"`
 	URL                      types.String         `tfsdk:"url"`
 	GroupID                  types.Int64          `tfsdk:"group_id"`
 	Tags                     types.Set            `tfsdk:"tags"`
+	TagsAll                  types.Set            `tfsdk:"tags_all"`
 	AssignedAlertContacts    types.Set            `tfsdk:"assigned_alert_contacts"`
 	ResponseTimeThreshold    types.Int64          `tfsdk:"response_time_threshold"`
 	RegionalData             types.String         `tfsdk:"regional_data"`
 	RegionData               types.Object         `tfsdk:"region_data"`
 	CheckSSLErrors           types.Bool           `tfsdk:"check_ssl_errors"`
 	Config                   types.Object         `tfsdk:"config"`
+	DefaultsApplied          types.Set            `tfsdk:"defaults_applied"`
+	Timeouts                 timeouts.Value       `tfsdk:"timeouts"`
+
+	// Write-only secrets. Terraform keeps them null in plan and state; see
+	// monitorWriteOnly.
+	HTTPPasswordWO             types.String `tfsdk:"http_password_wo"`
+	HTTPPasswordWOVersion      types.Int64  `tfsdk:"http_password_wo_version"`
+	CustomHTTPHeadersWO        types.Map    `tfsdk:"custom_http_headers_wo"`
+	CustomHTTPHeadersWOVersion types.Int64  `tfsdk:"custom_http_headers_wo_version"`
 }
 
 type alertContactTF struct {
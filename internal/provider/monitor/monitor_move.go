@@ -0,0 +1,242 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/legacyprovider"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
+)
+
+// legacyMonitorModel is the part of the louy/uptimerobot uptimerobot_monitor
+// state that carries over to API v3.
+type legacyMonitorModel struct {
+	ID                types.String `tfsdk:"id"`
+	FriendlyName      types.String `tfsdk:"friendly_name"`
+	URL               types.String `tfsdk:"url"`
+	Type              types.String `tfsdk:"type"`
+	SubType           types.String `tfsdk:"sub_type"`
+	Port              types.Int64  `tfsdk:"port"`
+	KeywordType       types.String `tfsdk:"keyword_type"`
+	KeywordValue      types.String `tfsdk:"keyword_value"`
+	Interval          types.Int64  `tfsdk:"interval"`
+	HTTPMethod        types.String `tfsdk:"http_method"`
+	HTTPUsername      types.String `tfsdk:"http_username"`
+	HTTPPassword      types.String `tfsdk:"http_password"`
+	HTTPAuthType      types.String `tfsdk:"http_auth_type"`
+	IgnoreSSLErrors   types.Bool   `tfsdk:"ignore_ssl_errors"`
+	CustomHTTPHeaders types.Map    `tfsdk:"custom_http_headers"`
+	AlertContact      types.List   `tfsdk:"alert_contact"`
+}
+
+type legacyMonitorAlertContact struct {
+	ID         types.String `tfsdk:"id"`
+	Threshold  types.Int64  `tfsdk:"threshold"`
+	Recurrence types.Int64  `tfsdk:"recurrence"`
+}
+
+func legacyMonitorSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                  schema.StringAttribute{Computed: true},
+			"friendly_name":       schema.StringAttribute{Required: true},
+			"url":                 schema.StringAttribute{Required: true},
+			"type":                schema.StringAttribute{Required: true},
+			"sub_type":            schema.StringAttribute{Optional: true},
+			"port":                schema.Int64Attribute{Optional: true},
+			"keyword_type":        schema.StringAttribute{Optional: true},
+			"keyword_value":       schema.StringAttribute{Optional: true},
+			"interval":            schema.Int64Attribute{Optional: true},
+			"http_method":         schema.StringAttribute{Optional: true},
+			"http_username":       schema.StringAttribute{Optional: true},
+			"http_password":       schema.StringAttribute{Optional: true, Sensitive: true},
+			"http_auth_type":      schema.StringAttribute{Optional: true},
+			"ignore_ssl_errors":   schema.BoolAttribute{Optional: true},
+			"custom_http_headers": schema.MapAttribute{Optional: true, ElementType: types.StringType},
+			"alert_contact": schema.ListAttribute{
+				Optional: true,
+				ElementType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"id":         types.StringType,
+					"threshold":  types.Int64Type,
+					"recurrence": types.Int64Type,
+				}},
+			},
+		},
+	}
+}
+
+// legacyMonitorTypes maps v2 monitor types to v3 ones.
+var legacyMonitorTypes = map[string]string{
+	"http":      MonitorTypeHTTP,
+	"keyword":   MonitorTypeKEYWORD,
+	"ping":      MonitorTypePING,
+	"port":      MonitorTypePORT,
+	"heartbeat": MonitorTypeHEARTBEAT,
+}
+
+// legacyMonitorSubTypePorts maps the predefined v2 port monitor sub types to
+// the port they check. The custom sub type uses the port attribute.
+var legacyMonitorSubTypePorts = map[string]int64{
+	"http":  80,
+	"https": 443,
+	"ftp":   21,
+	"smtp":  25,
+	"pop3":  110,
+	"imap":  143,
+}
+
+// MoveState moves uptimerobot_monitor resources of the community
+// louy/uptimerobot provider to this resource.
+func (r *monitorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: moveMonitorFromLegacy},
+	}
+}
+
+func moveMonitorFromLegacy(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	source := legacyprovider.SourceState(ctx, req, monitorResourceTypeName, legacyMonitorSchema(), &resp.Diagnostics)
+	if source == nil {
+		return
+	}
+
+	var legacy legacyMonitorModel
+	resp.Diagnostics.Append(source.Get(ctx, &legacy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	moved, diags := monitorFromLegacy(ctx, legacy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, moved)...)
+}
+
+// monitorFromLegacy translates v2 monitor state. Attributes v2 did not have
+// are left null or at their default and are filled in by the next read.
+func monitorFromLegacy(ctx context.Context, legacy legacyMonitorModel) (monitorResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	monitorType, ok := legacyMonitorTypes[strings.ToLower(legacy.Type.ValueString())]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Unsupported Monitor Type",
+			fmt.Sprintf("The %s monitor type %q has no API v3 equivalent.", legacyprovider.Address, legacy.Type.ValueString()),
+		)
+		return monitorResourceModel{}, diags
+	}
+
+	acSet, d := legacyAlertContactsToSet(ctx, legacy.AlertContact)
+	diags.Append(d...)
+
+	headers := legacy.CustomHTTPHeaders
+	if len(headers.Elements()) == 0 {
+		headers = types.MapNull(types.StringType)
+	}
+
+	moved := monitorResourceModel{
+		Type:                     types.StringValue(monitorType),
+		Interval:                 legacy.Interval,
+		SSLExpirationReminder:    types.BoolValue(false),
+		DomainExpirationReminder: types.BoolValue(false),
+		FollowRedirections:       types.BoolValue(false),
+		AuthType:                 types.StringValue("HTTP_BASIC"),
+		HTTPUsername:             legacy.HTTPUsername,
+		HTTPPassword:             legacy.HTTPPassword,
+		CustomHTTPHeaders:        headers,
+		CustomFields:             types.MapNull(types.StringType),
+		SuccessHTTPResponseCodes: types.SetNull(types.StringType),
+		PostValueKV:              types.MapNull(types.StringType),
+		MaintenanceWindowIDs:     types.SetNull(types.Int64Type),
+		ID:                       legacy.ID,
+		Name:                     legacy.FriendlyName,
+		URL:                      legacy.URL,
+		Tags:                     types.SetNull(types.StringType),
+		TagsAll:                  types.SetNull(types.StringType),
+		CustomFieldsAll:          types.MapNull(types.StringType),
+		DefaultsApplied:          types.SetNull(types.StringType),
+		AssignedAlertContacts:    acSet,
+		CheckSSLErrors:           types.BoolValue(false),
+		RegionData:               types.ObjectNull(regionDataObjectType().AttrTypes),
+		Config:                   types.ObjectNull(configObjectType().AttrTypes),
+		Timeouts:                 optimeout.Null(),
+		CustomHTTPHeadersWO:      types.MapNull(types.StringType),
+	}
+
+	if strings.EqualFold(legacy.HTTPAuthType.ValueString(), "digest") {
+		moved.AuthType = types.StringValue("DIGEST")
+	}
+	if method := legacy.HTTPMethod.ValueString(); method != "" {
+		moved.HTTPMethodType = types.StringValue(strings.ToUpper(method))
+	}
+	if !legacy.IgnoreSSLErrors.IsNull() {
+		moved.CheckSSLErrors = types.BoolValue(!legacy.IgnoreSSLErrors.ValueBool())
+	}
+
+	switch monitorType {
+	case MonitorTypeKEYWORD:
+		moved.KeywordValue = legacy.KeywordValue
+		switch strings.ToLower(legacy.KeywordType.ValueString()) {
+		case "exists":
+			moved.KeywordType = types.StringValue("ALERT_EXISTS")
+		case "not exists":
+			moved.KeywordType = types.StringValue("ALERT_NOT_EXISTS")
+		}
+	case MonitorTypePORT:
+		moved.Port = legacy.Port
+		if port, ok := legacyMonitorSubTypePorts[strings.ToLower(legacy.SubType.ValueString())]; ok {
+			moved.Port = types.Int64Value(port)
+		}
+	case MonitorTypeHEARTBEAT:
+		// v3 generates the heartbeat URL; the next read fills it in.
+		moved.URL = types.StringNull()
+	}
+
+	return moved, diags
+}
+
+// legacyAlertContactsToSet converts v2 alert_contact blocks to
+// assigned_alert_contacts.
+func legacyAlertContactsToSet(ctx context.Context, l types.List) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := alertContactObjectType()
+	if l.IsNull() || l.IsUnknown() || len(l.Elements()) == 0 {
+		return types.SetNull(elemType), diags
+	}
+
+	var contacts []legacyMonitorAlertContact
+	diags.Append(l.ElementsAs(ctx, &contacts, false)...)
+	if diags.HasError() {
+		return types.SetNull(elemType), diags
+	}
+
+	seen := make(map[string]struct{}, len(contacts))
+	elts := make([]alertContactTF, 0, len(contacts))
+	for _, c := range contacts {
+		id := strings.TrimSpace(c.ID.ValueString())
+		if id == "" {
+			continue
+		}
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		elts = append(elts, alertContactTF{
+			AlertContactID: types.StringValue(id),
+			Threshold:      types.Int64Value(c.Threshold.ValueInt64()),
+			Recurrence:     types.Int64Value(c.Recurrence.ValueInt64()),
+		})
+	}
+	out, d := types.SetValueFrom(ctx, elemType, elts)
+	diags.Append(d...)
+	return out, diags
+}
@@ -0,0 +1,124 @@
+package monitor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/legacyprovider"
+)
+
+func legacyMonitorMoveRequest(state string) resource.MoveStateRequest {
+	return resource.MoveStateRequest{
+		SourceProviderAddress: legacyprovider.Address,
+		SourceTypeName:        monitorResourceTypeName,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(state)},
+	}
+}
+
+func TestMoveMonitorFromLegacy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := monitorSchema(6, true)
+	resp := resource.MoveStateResponse{
+		TargetState: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	moveMonitorFromLegacy(ctx, legacyMonitorMoveRequest(`{
+		"id": "777", "friendly_name": "website", "url": "https://example.com", "type": "keyword",
+		"sub_type": "", "port": 0, "keyword_type": "not exists", "keyword_value": "error",
+		"interval": 300, "http_auth_type": "digest", "http_username": "u", "http_password": "p",
+		"ignore_ssl_errors": true, "custom_http_headers": {}, "status": "up",
+		"alert_contact": [{"id": "1", "threshold": 2, "recurrence": 0}, {"id": "1", "threshold": 2, "recurrence": 0}]
+	}`), &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var moved monitorResourceModel
+	if d := resp.TargetState.Get(ctx, &moved); d.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", d)
+	}
+	if moved.ID.ValueString() != "777" || moved.Name.ValueString() != "website" || moved.Interval.ValueInt64() != 300 {
+		t.Fatalf("unexpected moved state: %+v", moved)
+	}
+	if moved.Type.ValueString() != MonitorTypeKEYWORD || moved.KeywordType.ValueString() != "ALERT_NOT_EXISTS" {
+		t.Fatalf("expected a KEYWORD monitor alerting on a missing keyword, got %s %s", moved.Type, moved.KeywordType)
+	}
+	if moved.AuthType.ValueString() != "DIGEST" || moved.CheckSSLErrors.ValueBool() {
+		t.Fatalf("unexpected auth_type %s or check_ssl_errors %s", moved.AuthType, moved.CheckSSLErrors)
+	}
+	if !moved.CustomHTTPHeaders.IsNull() {
+		t.Fatalf("expected empty headers to move as null, got %s", moved.CustomHTTPHeaders)
+	}
+	if len(moved.AssignedAlertContacts.Elements()) != 1 {
+		t.Fatalf("expected one deduplicated alert contact, got %s", moved.AssignedAlertContacts)
+	}
+}
+
+func TestMonitorFromLegacy_Types(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	moved, diags := monitorFromLegacy(ctx, legacyMonitorModel{
+		Type:    types.StringValue("port"),
+		SubType: types.StringValue("https"),
+		Port:    types.Int64Value(0),
+	})
+	if diags.HasError() || moved.Port.ValueInt64() != 443 {
+		t.Fatalf("expected the https sub type to move as port 443, got %s (%v)", moved.Port, diags)
+	}
+
+	moved, diags = monitorFromLegacy(ctx, legacyMonitorModel{
+		Type:    types.StringValue("port"),
+		SubType: types.StringValue("custom"),
+		Port:    types.Int64Value(8443),
+	})
+	if diags.HasError() || moved.Port.ValueInt64() != 8443 {
+		t.Fatalf("expected the custom sub type to keep its port, got %s (%v)", moved.Port, diags)
+	}
+
+	_, diags = monitorFromLegacy(ctx, legacyMonitorModel{Type: types.StringValue("smtp")})
+	if !diags.HasError() {
+		t.Fatal("expected an unknown v2 monitor type to be rejected")
+	}
+}
+
+func TestTypedMonitorMoveFromLegacy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const state = `{"id": "9", "friendly_name": "ping", "url": "example.com", "type": "ping", "interval": 60}`
+
+	r := newTypedResource(MonitorTypePING)
+	s := testTypedSchema(t, r)
+	resp := resource.MoveStateResponse{
+		TargetState: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	r.moveFromLegacy(ctx, legacyMonitorMoveRequest(state), &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	var name types.String
+	resp.TargetState.GetAttribute(ctx, path.Root("name"), &name)
+	if name.ValueString() != "ping" {
+		t.Fatalf("expected the moved state to keep the name, got %s", name)
+	}
+
+	r = newTypedResource(MonitorTypeHTTP)
+	s = testTypedSchema(t, r)
+	resp = resource.MoveStateResponse{
+		TargetState: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	r.moveFromLegacy(ctx, legacyMonitorMoveRequest(state), &resp)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "uptimerobot_ping_monitor") {
+		t.Fatalf("expected a type mismatch error, got %v", resp.Diagnostics)
+	}
+}
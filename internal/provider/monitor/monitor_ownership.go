@@ -0,0 +1,105 @@
+package monitor
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+// monitorOwnership holds the provider ownership_stamp and
+// create_idempotency_tokens. Both are custom fields that are never planned:
+// they are added to requests and removed from what the API returns, so
+// enabling them does not show a diff on existing monitors.
+type monitorOwnership struct {
+	stamp        providerclient.OwnershipStamp
+	createTokens bool
+}
+
+// reservedCustomFields returns how many keys the stamp and the create token
+// add to the custom fields in fields.
+func (o monitorOwnership) reservedCustomFields(fields map[string]string) int {
+	n := len(o.stamped(fields)) - len(fields)
+	if _, ok := fields[createTokenField]; o.createTokens && !ok {
+		n++
+	}
+	return n
+}
+
+// stamped returns fields plus the ownership stamp. A key the configuration
+// sets itself is left alone. fields is not modified.
+func (o monitorOwnership) stamped(fields map[string]string) map[string]string {
+	if o.stamp.Key == "" {
+		return fields
+	}
+	if _, ok := fields[o.stamp.Key]; ok {
+		return fields
+	}
+	out := maps.Clone(fields)
+	if out == nil {
+		out = map[string]string{}
+	}
+	out[o.stamp.Key] = o.stamp.Value
+	return out
+}
+
+// stampUpdate adds the ownership stamp to every update, so that managing
+// custom_fields does not remove it and monitors created before the stamp was
+// enabled get it on their next update. An update that leaves the custom
+// fields alone is given the current ones from custom_fields_all (current),
+// since the API replaces them as a whole.
+func (o monitorOwnership) stampUpdate(ctx context.Context, req *client.UpdateMonitorRequest, current types.Map) {
+	if o.stamp.Key == "" {
+		return
+	}
+	if req.CustomFields == nil {
+		if current.IsNull() || current.IsUnknown() {
+			return
+		}
+		fields, diags := stringMapFromAttrPreserveEmpty(ctx, current)
+		if diags.HasError() {
+			return
+		}
+		req.CustomFields = &fields
+	}
+	fields := o.stamped(*req.CustomFields)
+	req.CustomFields = &fields
+}
+
+// unstamped drops the ownership stamp and the create token from the API
+// custom fields unless the resource or default_custom_fields (defaults) set
+// their key themselves. A stamp whose value was changed outside Terraform is
+// kept so it shows up as drift.
+func (o monitorOwnership) unstamped(ctx context.Context, fields types.Map, defaults map[string]string, api map[string]string) map[string]string {
+	var hidden []string
+	if v, ok := api[o.stamp.Key]; ok && o.stamp.Key != "" && v == o.stamp.Value {
+		hidden = append(hidden, o.stamp.Key)
+	}
+	if _, ok := api[createTokenField]; ok {
+		hidden = append(hidden, createTokenField)
+	}
+	if len(hidden) == 0 {
+		return api
+	}
+	own, _ := stringMapFromAttrPreserveEmpty(ctx, fields)
+	out := maps.Clone(api)
+	for _, key := range hidden {
+		_, isDefault := defaults[key]
+		_, isOwn := own[key]
+		if !isDefault && !isOwn {
+			delete(out, key)
+		}
+	}
+	return out
+}
+
+// hiddenCustomFields returns the custom field keys that unstamped may drop,
+// for comparisons against the API.
+func (o monitorOwnership) hiddenCustomFields() []string {
+	if o.stamp.Key == "" {
+		return []string{createTokenField}
+	}
+	return []string{o.stamp.Key, createTokenField}
+}
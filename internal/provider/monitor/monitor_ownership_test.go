@@ -0,0 +1,64 @@
+package monitor
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+func TestMonitorOwnership_Stamp(t *testing.T) {
+	t.Parallel()
+
+	o := monitorOwnership{stamp: providerclient.OwnershipStamp{Key: "managed_by", Value: "terraform:prod"}}
+
+	if got := o.stamped(nil); !reflect.DeepEqual(got, map[string]string{"managed_by": "terraform:prod"}) {
+		t.Fatalf("expected the stamp on an empty create, got %v", got)
+	}
+	if got := o.stamped(map[string]string{"managed_by": "me"}); got["managed_by"] != "me" {
+		t.Fatalf("expected a configured key to win, got %v", got)
+	}
+
+	api := map[string]string{"managed_by": "terraform:prod", "team": "a"}
+	if got := o.unstamped(context.Background(), types.MapNull(types.StringType), nil, api); !reflect.DeepEqual(got, map[string]string{"team": "a"}) {
+		t.Fatalf("expected the stamp to be hidden, got %v", got)
+	}
+	changed := map[string]string{"managed_by": "someone"}
+	if got := o.unstamped(context.Background(), types.MapNull(types.StringType), nil, changed); !reflect.DeepEqual(got, changed) {
+		t.Fatalf("expected a changed stamp to show as drift, got %v", got)
+	}
+
+	fields := map[string]string{"team": "a"}
+	req := &client.UpdateMonitorRequest{CustomFields: &fields}
+	o.stampUpdate(context.Background(), req, types.MapNull(types.StringType))
+	if (*req.CustomFields)["managed_by"] != "terraform:prod" || len(fields) != 1 {
+		t.Fatalf("expected the update to keep the stamp without modifying the plan map, got %v", *req.CustomFields)
+	}
+
+	// An update that leaves custom fields unmanaged stamps the monitor with
+	// its current fields kept.
+	current, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{"team": "b"})
+	req = &client.UpdateMonitorRequest{}
+	o.stampUpdate(context.Background(), req, current)
+	if req.CustomFields == nil || !reflect.DeepEqual(*req.CustomFields, map[string]string{"team": "b", "managed_by": "terraform:prod"}) {
+		t.Fatalf("expected the current fields plus the stamp, got %v", req.CustomFields)
+	}
+}
+
+func TestMonitorOwnership_ReservedCustomFields(t *testing.T) {
+	t.Parallel()
+
+	o := monitorOwnership{stamp: providerclient.OwnershipStamp{Key: "managed_by", Value: "terraform:prod"}, createTokens: true}
+	if got := o.reservedCustomFields(map[string]string{"team": "a"}); got != 2 {
+		t.Fatalf("expected the stamp and the create token to reserve two keys, got %d", got)
+	}
+	if got := o.reservedCustomFields(map[string]string{"managed_by": "me"}); got != 1 {
+		t.Fatalf("expected a configured stamp key not to be counted again, got %d", got)
+	}
+	if got := (monitorOwnership{}).reservedCustomFields(nil); got != 0 {
+		t.Fatalf("expected nothing reserved without stamp or tokens, got %d", got)
+	}
+}
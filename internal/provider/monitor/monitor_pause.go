@@ -9,6 +9,10 @@ import (
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
 )
 
+// pauseSettleTimeout is how long to wait for a pause or start to be reflected
+// when no timeout is configured.
+const pauseSettleTimeout = 90 * time.Second
+
 func isMonitorPausedStatus(status string) bool {
 	return strings.EqualFold(strings.TrimSpace(status), "PAUSED")
 }
@@ -17,6 +21,7 @@ func (r *monitorResource) ensureMonitorPausedState(
 	ctx context.Context,
 	id int64,
 	wantPaused bool,
+	timeout time.Duration,
 ) (*client.Monitor, error) {
 	var (
 		m   *client.Monitor
@@ -35,7 +40,7 @@ func (r *monitorResource) ensureMonitorPausedState(
 		}
 	}
 
-	settled, waitErr := r.waitMonitorPauseState(ctx, id, wantPaused, 90*time.Second)
+	settled, waitErr := r.waitMonitorPauseState(ctx, id, wantPaused, timeout)
 	if waitErr != nil {
 		if settled != nil {
 			if isMonitorPausedStatus(settled.Status) == wantPaused {
@@ -62,58 +67,14 @@ func (r *monitorResource) waitMonitorPauseState(
 	wantPaused bool,
 	timeout time.Duration,
 ) (*client.Monitor, error) {
-	if timeout <= 0 {
-		timeout = 30 * time.Second
-	}
-	if dl, ok := ctx.Deadline(); ok {
-		if rem := time.Until(dl); rem > 0 && rem < timeout {
-			timeout = rem
-		}
-	}
-
-	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	waitCtx, cancel := context.WithTimeout(ctx, monitorWaitTimeout(ctx, timeout))
 	defer cancel()
 
-	var last *client.Monitor
-	backoff := 500 * time.Millisecond
-	const maxBackoff = 3 * time.Second
-	requiredConsecutiveMatches := 5
-	consecutiveMatches := 0
-
-	for attempt := 0; ; attempt++ {
-		m, err := r.client.GetMonitor(waitCtx, id)
-		if err == nil {
-			last = m
-			if isMonitorPausedStatus(m.Status) == wantPaused {
-				consecutiveMatches++
-				if consecutiveMatches >= requiredConsecutiveMatches {
-					return m, nil
-				}
-			} else {
-				consecutiveMatches = 0
-			}
-		} else {
-			consecutiveMatches = 0
-		}
-
-		wait := backoff
-		if wait > maxBackoff {
-			wait = maxBackoff
-		}
-		select {
-		case <-waitCtx.Done():
-			if last != nil {
-				if isMonitorPausedStatus(last.Status) == wantPaused {
-					return last, nil
-				}
-				return last, fmt.Errorf("timeout waiting for monitor pause state to settle: %w", waitCtx.Err())
-			}
-			return nil, fmt.Errorf("timeout waiting for monitor pause state to settle: %w", waitCtx.Err())
-		case <-time.After(wait):
-		}
-
-		if attempt < 4 {
-			backoff *= 2
-		}
+	last, err := pollMonitor(waitCtx, r.client, id, 5, func(m *client.Monitor) bool {
+		return isMonitorPausedStatus(m.Status) == wantPaused
+	})
+	if err != nil {
+		return last, fmt.Errorf("timeout waiting for monitor pause state to settle: %w", err)
 	}
+	return last, nil
 }
@@ -5,9 +5,16 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// monitorTypeRequiresReplace replaces the monitor when type changes between
+// types that cannot be converted in place; see monitorTypeConversions.
+func monitorTypeRequiresReplace(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !monitorTypeConvertible(req.StateValue.ValueString(), req.PlanValue.ValueString())
+}
+
 // configNullIfOmitted is a plan modifier for the monitor's config attribute.
 // It handles two cases:
 //  1. When config is omitted: forces NULL to prevent Terraform from carrying
@@ -18,7 +25,11 @@ import (
 // This normalization is required due to a terraform-plugin-framework limitation
 // where SingleNestedAttribute doesn't auto-fill missing nested attributes.
 // See: https://github.com/hashicorp/terraform-plugin-framework/issues/716
-type configNullIfOmitted struct{}
+type configNullIfOmitted struct {
+	// attrTypes are the config attributes of the schema. Nil means the full
+	// uptimerobot_monitor config; the typed monitor resources have fewer.
+	attrTypes map[string]attr.Type
+}
 
 func (m configNullIfOmitted) Description(ctx context.Context) string {
 	return "Force null when the config block is omitted and normalize partial objects"
@@ -31,7 +42,10 @@ func (m configNullIfOmitted) MarkdownDescription(ctx context.Context) string {
 //   - If omitted or unknown: use prior state on update, NULL on create
 //   - If partial (e.g., only ssl_expiration_period_days): normalize to include all expected attributes
 func (m configNullIfOmitted) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
-	want := configObjectType().AttrTypes
+	want := m.attrTypes
+	if want == nil {
+		want = configObjectType().AttrTypes
+	}
 
 	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		switch {
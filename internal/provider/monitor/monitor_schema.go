@@ -3,6 +3,7 @@ package monitor
 import (
 	"context"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
@@ -26,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/deletionprotection"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
 )
 
@@ -62,18 +65,49 @@ var (
 	_ resource.ResourceWithModifyPlan       = &monitorResource{}
 	_ resource.ResourceWithImportState      = &monitorResource{}
 	_ resource.ResourceWithUpgradeState     = &monitorResource{}
+	_ resource.ResourceWithMoveState        = &monitorResource{}
 	_ resource.ResourceWithConfigValidators = &monitorResource{}
 	_ resource.ResourceWithValidateConfig   = &monitorResource{}
 )
 
 // monitorResource is the resource implementation.
 type monitorResource struct {
-	client *client.Client
+	client            *client.Client
+	defaults          monitorDefaultTags
+	ownership         monitorOwnership
+	monitorDefaults   *monitorDefaultsTF
+	account           *providerclient.Account
+	protectAll        bool
+	registry          *providerclient.MonitorRegistry
+	duplicateCheck    string
+	subscriptionCheck subscriptionCheck
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *monitorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerclient.FromResourceConfigure(req, resp)
+// Configure adds the provider configured client and defaults to the resource.
+func (r *monitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := providerclient.DataFromResourceConfigure(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+	r.account = data.Account
+	r.protectAll = data.ProtectAll
+	r.registry = data.Monitors
+	r.duplicateCheck = data.DuplicateMonitorCheck
+	r.defaults = monitorDefaultTags{
+		tags:         normalizeTagSet(data.DefaultTags),
+		customFields: data.DefaultCustomFields,
+	}
+	r.ownership = monitorOwnership{createTokens: data.CreateTokens}
+	if data.OwnershipStamp != nil {
+		r.ownership.stamp = *data.OwnershipStamp
+	}
+	monitorDefaults, diags := monitorDefaultsFromProvider(ctx, data.MonitorDefaults)
+	resp.Diagnostics.Append(diags...)
+	r.monitorDefaults = monitorDefaults
+	subscriptionCheck, diags := subscriptionCheckFromProvider(ctx, data.SubscriptionCheck)
+	resp.Diagnostics.Append(diags...)
+	r.subscriptionCheck = subscriptionCheck
 }
 
 // Metadata returns the resource type name.
@@ -107,12 +141,17 @@ func monitorSchema(version int64, includeApplicationErrorRetries bool) schema.Sc
 					),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						monitorTypeRequiresReplace,
+						"Changing type between HTTP, KEYWORD and API, or between PING and PORT, updates the monitor in place. Any other type change replaces it.",
+						"Changing type between HTTP, KEYWORD and API, or between PING and PORT, updates the monitor in place. Any other type change replaces it.",
+					),
 				},
 			},
 			"interval": schema.Int64Attribute{
-				Description: "Interval for the monitoring check (in seconds). HEARTBEAT monitors support at most 2678400 seconds (31 days).",
-				Required:    true,
+				Description: "Interval for the monitoring check (in seconds). HEARTBEAT monitors support at most 2678400 seconds (31 days). Required unless the provider monitor_defaults block sets interval.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.Int64{
 					int64validator.AtLeast(30),
 				},
@@ -173,6 +212,45 @@ func monitorSchema(version int64, includeApplicationErrorRetries bool) schema.Sc
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"http_password_wo": schema.StringAttribute{
+				Description: "Write-only password for HTTP authentication. It is never stored in state and requires Terraform 1.11 or later. " +
+					"Change http_password_wo_version to send a new value.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+					stringvalidator.ConflictsWith(path.MatchRoot("http_password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("http_password_wo_version")),
+				},
+			},
+			"http_password_wo_version": schema.Int64Attribute{
+				Description: "Version of http_password_wo. The password is sent to the API on create and whenever this value changes.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("http_password_wo")),
+				},
+			},
+			"custom_http_headers_wo": schema.MapAttribute{
+				Description: "Write-only custom HTTP headers, for example bearer tokens. They are never stored in state and require Terraform 1.11 or later. " +
+					"Change custom_http_headers_wo_version to send new values.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(path.MatchRoot("custom_http_headers")),
+					mapvalidator.AlsoRequires(path.MatchRoot("custom_http_headers_wo_version")),
+				},
+			},
+			"custom_http_headers_wo_version": schema.Int64Attribute{
+				Description: "Version of custom_http_headers_wo. The headers are sent to the API on create and whenever this value changes. " +
+					"Removing both attributes clears the headers.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("custom_http_headers_wo")),
+				},
+			},
 			"custom_fields": schema.MapAttribute{
 				Description: "Custom key-value metadata for the monitor. Max 20 keys. Keys may contain letters, numbers, underscores, and hyphens. Values may be up to 255 characters.",
 				MarkdownDescription: "Custom key-value metadata for the monitor.\n\n" +
@@ -197,6 +275,16 @@ func monitorSchema(version int64, includeApplicationErrorRetries bool) schema.Sc
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"defaults_applied": schema.SetAttribute{
+				Description: "Attributes this monitor took from the provider `monitor_defaults` block because it leaves them unset.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"custom_fields_all": schema.MapAttribute{
+				Description: "All custom fields of the monitor: the provider `default_custom_fields` overridden by `custom_fields`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"http_method_type": schema.StringAttribute{
 				Description: "The HTTP method type (HEAD, GET, POST, PUT, PATCH, DELETE, OPTIONS, QUERY). HEAD is not supported for API monitors; use HTTP monitors for status/header-only HEAD checks.",
 				Optional:    true,
@@ -292,6 +380,7 @@ func monitorSchema(version int64, includeApplicationErrorRetries bool) schema.Sc
 				Optional:    true,
 				ElementType: types.Int64Type,
 			},
+			deletionprotection.AttributeName: deletionprotection.Attribute(),
 			"id": schema.StringAttribute{
 				Description: "Monitor ID",
 				Computed:    true,
@@ -369,6 +458,11 @@ func monitorSchema(version int64, includeApplicationErrorRetries bool) schema.Sc
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"tags_all": schema.SetAttribute{
+				Description: "All tags of the monitor: `tags` plus the provider `default_tags`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"assigned_alert_contacts": schema.SetNestedAttribute{
 				Description: "Alert contacts or integrations to assign. Each item must include `alert_contact_id`, `threshold`, and `recurrence`." +
 					"Free plan have to use 0 for threshold and recurrence",
@@ -383,6 +477,7 @@ Alert contacts and integrations assigned to this monitor.
 - **Paid plans**: any non-negative minutes for both fields.
 `,
 				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.UseStateForUnknown(),
 				},
@@ -445,6 +540,7 @@ Alert contacts and integrations assigned to this monitor.
 					"- `auto_select` lets UptimeRobot choose the monitoring region automatically. When `true`, `regions` can be omitted and the provider sends the API-required carrier region without managing it. When omitted or `false`, configured `regions` are used as manually selected regions.\n" +
 					"- `thresholds` optionally sets per-region response-time thresholds in milliseconds. Keys must be selected regions and values must be between `0` and `60000`.",
 				Optional: true,
+				Computed: true,
 				Attributes: map[string]schema.Attribute{
 					"regions": schema.SetAttribute{
 						Description: "Active monitoring regions: na (North America), eu (Europe), as (Asia), oc (Oceania). Required unless auto_select is true.",
@@ -647,6 +743,9 @@ Advanced monitor configuration.
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			optimeout.BlockName: optimeout.Block(),
+		},
 	}
 
 	if !includeApplicationErrorRetries {
@@ -686,8 +785,48 @@ func (r *monitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	r.defaults.modifyPlan(ctx, req, resp, r.ownership.reservedCustomFields)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	planType := strings.ToUpper(firstNonEmpty(stringOrEmpty(plan.Type), stringOrEmpty(state.Type)))
 
+	var config monitorResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	applied := applyMonitorDefaults(r.monitorDefaults, planType, config, &plan)
+	if config.Interval.IsNull() && !slices.Contains(applied, "interval") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("interval"),
+			"Missing interval",
+			"Set interval on the monitor, or set interval in the provider monitor_defaults block.",
+		)
+		return
+	}
+	defaultsApplied := types.SetNull(types.StringType)
+	if len(applied) > 0 {
+		defaultsApplied = stringSetValue(applied)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("interval"), plan.Interval)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("timeout"), plan.Timeout)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("assigned_alert_contacts"), plan.AssignedAlertContacts)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ssl_expiration_reminder"), plan.SSLExpirationReminder)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("follow_redirections"), plan.FollowRedirections)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("region_data"), plan.RegionData)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("defaults_applied"), defaultsApplied)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkSubscription(ctx, req.State.Raw.IsNull(), planType, plan, state, &resp.Diagnostics)
+	r.checkDuplicates(ctx, req.State.Raw.IsNull(), planType, plan, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !plan.AssignedAlertContacts.IsNull() && !plan.AssignedAlertContacts.IsUnknown() {
 		var acs []alertContactTF
 		resp.Diagnostics.Append(plan.AssignedAlertContacts.ElementsAs(ctx, &acs, false)...)
@@ -818,11 +957,15 @@ func (r *monitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		}
 	}
 
-	if (planType == MonitorTypeDNS || planType == MonitorTypeAPI || planType == MonitorTypeUDP) && req.State.Raw.IsNull() &&
+	// A type change needs the type-specific config just like a create does,
+	// whether it converts the monitor in place or replaces it.
+	converted := !req.State.Raw.IsNull() && !plan.Type.IsUnknown() &&
+		!strings.EqualFold(stringOrEmpty(state.Type), stringOrEmpty(plan.Type))
+	if (planType == MonitorTypeDNS || planType == MonitorTypeAPI || planType == MonitorTypeUDP) && (req.State.Raw.IsNull() || converted) &&
 		(plan.Config.IsNull() || plan.Config.IsUnknown()) {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("config"),
-			"`config` is required for DNS/API/UDP monitors on create",
+			"`config` is required for DNS/API/UDP monitors on create or type change",
 			"For DNS use `config = {}` or set DNS fields. For API set `config.api_assertions` with logic and checks. For UDP set `config.udp.packet_loss_threshold`.",
 		)
 	}
@@ -0,0 +1,153 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+// Values of the provider subscription_check mode attribute.
+const (
+	subscriptionCheckWarning = "warning"
+	subscriptionCheckError   = "error"
+)
+
+// subscriptionCheckTF is the provider subscription_check block.
+type subscriptionCheckTF struct {
+	Mode            types.String `tfsdk:"mode"`
+	MinInterval     types.Int64  `tfsdk:"min_interval"`
+	MonitorTypes    types.Set    `tfsdk:"monitor_types"`
+	RegionSelection types.Bool   `tfsdk:"region_selection"`
+}
+
+// subscriptionCheck holds the subscription_check settings. GET /user/me only
+// reports the monitor limit of the plan, so the other limits are the ones the
+// configuration sets, and are not checked when it leaves them out.
+type subscriptionCheck struct {
+	// mode is subscriptionCheckError when findings fail the plan; they are
+	// warnings otherwise.
+	mode string
+	// minInterval is the shortest supported interval, 0 when not checked.
+	minInterval int64
+	// types are the supported monitor types; nil means all.
+	types []string
+	// noRegions reports that region selection is not supported.
+	noRegions bool
+}
+
+// subscriptionCheckFromProvider decodes the subscription_check block. A block
+// that is not set checks the monitor limit and reports findings as warnings.
+func subscriptionCheckFromProvider(ctx context.Context, block types.Object) (subscriptionCheck, diag.Diagnostics) {
+	if block.IsNull() || block.IsUnknown() {
+		return subscriptionCheck{}, nil
+	}
+	var tf subscriptionCheckTF
+	diags := block.As(ctx, &tf, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return subscriptionCheck{}, diags
+	}
+	check := subscriptionCheck{
+		mode:        tf.Mode.ValueString(),
+		minInterval: tf.MinInterval.ValueInt64(),
+		noRegions:   !tf.RegionSelection.IsNull() && !tf.RegionSelection.ValueBool(),
+	}
+	if !tf.MonitorTypes.IsNull() && !tf.MonitorTypes.IsUnknown() {
+		diags.Append(tf.MonitorTypes.ElementsAs(ctx, &check.types, false)...)
+		slices.Sort(check.types)
+	}
+	return check, diags
+}
+
+// checkSubscription reports a planned monitor that does not fit the account
+// subscription: a create beyond the remaining monitor slots, or an interval,
+// monitor type or region selection outside the limits set in
+// subscription_check. They are warnings unless its mode is "error", since
+// deletes in the same run free slots and plans change over time. Only creates
+// and changed values are checked, so an existing monitor is not reported on
+// every plan.
+func (r *monitorResource) checkSubscription(ctx context.Context, creating bool, monitorType string, plan, state monitorResourceModel, diags *diag.Diagnostics) {
+	if r.account == nil {
+		return
+	}
+	intervalChanged := !plan.Interval.IsUnknown() && !plan.Interval.IsNull() && (creating || !plan.Interval.Equal(state.Interval))
+	regionsChanged := (!plan.RegionData.IsNull() && (creating || !plan.RegionData.Equal(state.RegionData))) ||
+		(!plan.RegionalData.IsNull() && (creating || !plan.RegionalData.Equal(state.RegionalData)))
+
+	if creating {
+		r.checkMonitorLimit(ctx, diags)
+	}
+
+	limits := r.subscriptionCheck
+	if intervalChanged && limits.minInterval > 0 && plan.Interval.ValueInt64() < limits.minInterval {
+		r.addSubscriptionFinding(diags, path.Root("interval"),
+			"Interval not supported by the subscription",
+			fmt.Sprintf("subscription_check sets a minimum interval of %d seconds; the API is likely to reject %d.",
+				limits.minInterval, plan.Interval.ValueInt64()),
+		)
+	}
+	if creating && monitorType != "" && limits.types != nil && !slices.Contains(limits.types, monitorType) {
+		r.addSubscriptionFinding(diags, path.Empty(),
+			"Monitor type not supported by the subscription",
+			fmt.Sprintf("subscription_check allows %s monitors; the API is likely to reject a %s monitor.",
+				strings.Join(limits.types, ", "), monitorType),
+		)
+	}
+	if regionsChanged && limits.noRegions {
+		r.addSubscriptionFinding(diags, path.Root("region_data"),
+			"Region selection not supported by the subscription",
+			"subscription_check sets region_selection to false; the API is likely to reject region_data and regional_data.",
+		)
+	}
+}
+
+// checkMonitorLimit reports a planned create beyond the monitor limit the API
+// reports for the account.
+func (r *monitorResource) checkMonitorLimit(ctx context.Context, diags *diag.Diagnostics) {
+	user, err := r.account.User(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "skipping the monitor limit check, the current user could not be read", map[string]any{"error": err.Error()})
+		return
+	}
+	planned := r.account.PlanMonitorCreate()
+	existing := r.account.MonitorsBeforeCreates(user)
+	if limit := monitorLimit(user); limit > 0 && existing+planned > limit {
+		r.addSubscriptionFinding(diags, path.Empty(),
+			"Monitor limit exceeded",
+			fmt.Sprintf(
+				"The account has %d of %d monitors, and this plan creates at least %d more. "+
+					"Creates beyond the limit fail during apply unless monitors deleted in the same run free enough slots.",
+				existing, limit, planned,
+			),
+		)
+	}
+}
+
+func (r *monitorResource) addSubscriptionFinding(diags *diag.Diagnostics, attr path.Path, summary, detail string) {
+	switch {
+	case r.subscriptionCheck.mode == subscriptionCheckError && attr.Equal(path.Empty()):
+		diags.AddError(summary, detail)
+	case r.subscriptionCheck.mode == subscriptionCheckError:
+		diags.AddAttributeError(attr, summary, detail)
+	case attr.Equal(path.Empty()):
+		diags.AddWarning(summary, detail)
+	default:
+		diags.AddAttributeWarning(attr, summary, detail)
+	}
+}
+
+// monitorLimit returns the monitor limit of the subscription, falling back
+// to the account limit.
+func monitorLimit(user *client.CurrentUser) int64 {
+	if user.ActiveSubscription.MonitorLimit > 0 {
+		return user.ActiveSubscription.MonitorLimit
+	}
+	return user.MonitorLimit
+}
@@ -0,0 +1,170 @@
+package monitor
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+func subscriptionTestPlan(interval int64) monitorResourceModel {
+	return monitorResourceModel{
+		Type:         types.StringValue(MonitorTypeHTTP),
+		Interval:     types.Int64Value(interval),
+		RegionData:   types.ObjectNull(regionDataObjectType().AttrTypes),
+		RegionalData: types.StringNull(),
+	}
+}
+
+func TestCheckSubscription_WarnsOnceCreatesExceedMonitorLimit(t *testing.T) {
+	t.Parallel()
+
+	user := &client.CurrentUser{
+		MonitorsCount:      49,
+		ActiveSubscription: client.CurrentUserSubscription{Plan: "Team", MonitorLimit: 50},
+	}
+	r := &monitorResource{account: providerclient.NewAccount(nil, user)}
+
+	var first, second diag.Diagnostics
+	r.checkSubscription(context.Background(), true, MonitorTypeHTTP, subscriptionTestPlan(60), monitorResourceModel{}, &first)
+	r.checkSubscription(context.Background(), true, MonitorTypeHTTP, subscriptionTestPlan(60), monitorResourceModel{}, &second)
+
+	if first.WarningsCount() != 0 {
+		t.Fatalf("expected the first create to fit, got %v", first)
+	}
+	if second.WarningsCount() != 1 || second.Warnings()[0].Summary() != "Monitor limit exceeded" {
+		t.Fatalf("expected a monitor limit warning for the second create, got %v", second)
+	}
+}
+
+func TestCheckSubscription_FlagsConfiguredLimits(t *testing.T) {
+	t.Parallel()
+
+	user := &client.CurrentUser{ActiveSubscription: client.CurrentUserSubscription{Plan: "Free"}}
+	r := &monitorResource{account: providerclient.NewAccount(nil, user)}
+
+	plan := subscriptionTestPlan(30)
+	plan.Type = types.StringValue(MonitorTypeHEARTBEAT)
+	plan.RegionalData = types.StringValue("eu")
+
+	// Without limits in subscription_check, only the monitor limit is checked.
+	var diags diag.Diagnostics
+	r.checkSubscription(context.Background(), true, MonitorTypeHEARTBEAT, plan, monitorResourceModel{}, &diags)
+	if diags.WarningsCount() != 0 {
+		t.Fatalf("expected no warnings without configured limits, got %v", diags)
+	}
+
+	r.subscriptionCheck = subscriptionCheck{
+		minInterval: 300,
+		types:       []string{MonitorTypeHTTP, MonitorTypeKEYWORD, MonitorTypePING, MonitorTypePORT},
+		noRegions:   true,
+	}
+	diags = nil
+	r.checkSubscription(context.Background(), true, MonitorTypeHEARTBEAT, plan, monitorResourceModel{}, &diags)
+	if diags.WarningsCount() != 3 {
+		t.Fatalf("expected interval, type and region warnings, got %v", diags)
+	}
+
+	// An existing monitor whose interval did not change is not flagged again.
+	diags = nil
+	r.checkSubscription(context.Background(), false, MonitorTypeHTTP, subscriptionTestPlan(30), subscriptionTestPlan(30), &diags)
+	if diags.WarningsCount() != 0 {
+		t.Fatalf("expected no warnings for an unchanged monitor, got %v", diags)
+	}
+}
+
+func TestCheckSubscription_CountsEveryPlannedCreate(t *testing.T) {
+	t.Parallel()
+
+	r := newRecoverTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(`{"monitorsCount":49,"activeSubscription":{"plan":"Team","monitorLimit":50}}`))
+	})
+	r.account = providerclient.NewAccount(r.client, nil)
+	ctx := context.Background()
+
+	// One monitor was created by this provider instance before the account
+	// was read, so the 49 monitors include it.
+	r.account.MonitorCreated()
+
+	plan := func(name string) monitorResourceModel {
+		p := subscriptionTestPlan(60)
+		p.Name = types.StringValue(name)
+		return p
+	}
+	var diags diag.Diagnostics
+	r.checkSubscription(ctx, true, MonitorTypeHTTP, plan("web"), monitorResourceModel{}, &diags)
+	r.checkSubscription(ctx, true, MonitorTypeHTTP, plan(""), monitorResourceModel{}, &diags)
+	if diags.WarningsCount() != 0 {
+		t.Fatalf("expected two creates to fit, got %v", diags)
+	}
+
+	// A second monitor with the same name is another create.
+	r.checkSubscription(ctx, true, MonitorTypeHTTP, plan("web"), monitorResourceModel{}, &diags)
+	if diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "48 of 50 monitors") {
+		t.Fatalf("expected a monitor limit warning for the third create, got %v", diags)
+	}
+}
+
+func TestCheckSubscription_ErrorMode(t *testing.T) {
+	t.Parallel()
+
+	user := &client.CurrentUser{ActiveSubscription: client.CurrentUserSubscription{Plan: "Free"}}
+	r := &monitorResource{
+		account:           providerclient.NewAccount(nil, user),
+		subscriptionCheck: subscriptionCheck{mode: subscriptionCheckError, minInterval: 60},
+	}
+
+	var diags diag.Diagnostics
+	r.checkSubscription(context.Background(), true, MonitorTypeHTTP, subscriptionTestPlan(30), monitorResourceModel{}, &diags)
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 0 {
+		t.Fatalf("expected the interval finding as an error, got %v", diags)
+	}
+
+	diags = nil
+	r.subscriptionCheck.mode = subscriptionCheckWarning
+	r.checkSubscription(context.Background(), true, MonitorTypeHTTP, subscriptionTestPlan(30), monitorResourceModel{}, &diags)
+	if diags.ErrorsCount() != 0 || diags.WarningsCount() != 1 {
+		t.Fatalf("expected the interval finding as a warning, got %v", diags)
+	}
+}
+
+func TestSubscriptionCheckFromProvider(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"mode":             types.StringType,
+		"min_interval":     types.Int64Type,
+		"monitor_types":    types.SetType{ElemType: types.StringType},
+		"region_selection": types.BoolType,
+	}
+	ctx := context.Background()
+
+	check, diags := subscriptionCheckFromProvider(ctx, types.ObjectNull(attrTypes))
+	if diags.HasError() || check.mode != "" || check.minInterval != 0 || check.types != nil || check.noRegions {
+		t.Fatalf("expected an unset block to set no limits, got %+v, %v", check, diags)
+	}
+
+	block := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"mode":             types.StringValue(subscriptionCheckError),
+		"min_interval":     types.Int64Value(300),
+		"monitor_types":    types.SetValueMust(types.StringType, []attr.Value{types.StringValue(MonitorTypePORT), types.StringValue(MonitorTypeHTTP)}),
+		"region_selection": types.BoolValue(false),
+	})
+	check, diags = subscriptionCheckFromProvider(ctx, block)
+	want := subscriptionCheck{
+		mode:        subscriptionCheckError,
+		minInterval: 300,
+		types:       []string{MonitorTypeHTTP, MonitorTypePORT},
+		noRegions:   true,
+	}
+	if diags.HasError() || !reflect.DeepEqual(check, want) {
+		t.Fatalf("expected %+v, got %+v, %v", want, check, diags)
+	}
+}
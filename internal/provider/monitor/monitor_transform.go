@@ -974,3 +974,55 @@ var allowedRegion = map[string]struct{}{"na": {}, "eu": {}, "as": {}, "oc": {}}
 func coerceRegion(v interface{}) (string, bool) {
 	return firstRegionFromAPI(v)
 }
+
+// Provider monitor_defaults helpers
+
+// applyMonitorDefaults fills the attributes config leaves null from the
+// provider monitor_defaults block and returns the names of the attributes it
+// filled, for defaults_applied. Defaults that do not apply to monitorType are
+// skipped. Without a default, a null assigned_alert_contacts or region_data
+// stays null so it remains unmanaged.
+func applyMonitorDefaults(defaults *monitorDefaultsTF, monitorType string, config monitorResourceModel, plan *monitorResourceModel) []string {
+	if config.AssignedAlertContacts.IsNull() {
+		plan.AssignedAlertContacts = types.SetNull(alertContactObjectType())
+	}
+	if config.RegionData.IsNull() {
+		plan.RegionData = types.ObjectNull(regionDataObjectType().AttrTypes)
+	}
+	if defaults == nil {
+		return nil
+	}
+
+	httpLike := monitorType == MonitorTypeHTTP || monitorType == MonitorTypeKEYWORD || monitorType == MonitorTypeAPI
+	var applied []string
+
+	if config.Interval.IsNull() && !defaults.Interval.IsNull() {
+		plan.Interval = defaults.Interval
+		applied = append(applied, "interval")
+	}
+	if config.Timeout.IsNull() && !defaults.Timeout.IsNull() &&
+		monitorType != "" && monitorType != MonitorTypeHEARTBEAT && monitorType != MonitorTypeDNS {
+		plan.Timeout = defaults.Timeout
+		applied = append(applied, "timeout")
+	}
+	if config.AssignedAlertContacts.IsNull() && !defaults.AssignedAlertContacts.IsNull() {
+		plan.AssignedAlertContacts = defaults.AssignedAlertContacts
+		applied = append(applied, "assigned_alert_contacts")
+	}
+	if config.SSLExpirationReminder.IsNull() && !defaults.SSLExpirationReminder.IsNull() && httpLike &&
+		!config.URL.IsNull() && !config.URL.IsUnknown() &&
+		strings.HasPrefix(strings.ToLower(config.URL.ValueString()), "https://") {
+		plan.SSLExpirationReminder = defaults.SSLExpirationReminder
+		applied = append(applied, "ssl_expiration_reminder")
+	}
+	if config.FollowRedirections.IsNull() && !defaults.FollowRedirections.IsNull() && httpLike {
+		plan.FollowRedirections = defaults.FollowRedirections
+		applied = append(applied, "follow_redirections")
+	}
+	if config.RegionData.IsNull() && config.RegionalData.IsNull() && !defaults.RegionData.IsNull() &&
+		monitorType != "" && monitorType != MonitorTypeHEARTBEAT {
+		plan.RegionData = defaults.RegionData
+		applied = append(applied, "region_data")
+	}
+	return applied
+}
@@ -152,7 +152,7 @@ func TestApplyUpdatedMonitorToState_ManagedEmptyConfig_NotSent_Preserved(t *test
 	}
 	resp := &resource.UpdateResponse{}
 
-	out := applyUpdatedMonitorToState(ctx, plan, prev, m, "GET", false, resp)
+	out := applyUpdatedMonitorToState(ctx, monitorDefaultTags{}, monitorOwnership{}, plan, prev, m, "GET", false, resp)
 	if resp.Diagnostics.HasError() {
 		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
 	}
@@ -242,7 +242,7 @@ func TestApplyUpdatedMonitorToState_PortPreservesEquivalentConfiguredURL(t *test
 	}
 	resp := &resource.UpdateResponse{}
 
-	got := applyUpdatedMonitorToState(ctx, plan, monitorResourceModel{}, &client.Monitor{
+	got := applyUpdatedMonitorToState(ctx, monitorDefaultTags{}, monitorOwnership{}, plan, monitorResourceModel{}, &client.Monitor{
 		Name:    "port",
 		URL:     "example.com/port",
 		Type:    MonitorTypePORT,
@@ -1025,3 +1025,89 @@ func TestFlattenConfigToState_UDPFromAPI_PopulatesObject(t *testing.T) {
 		t.Fatalf("expected packet_loss_threshold=50, got %#v", udp.PacketLossThreshold)
 	}
 }
+
+func nullMonitorDefaultsConfig(url string) monitorResourceModel {
+	return monitorResourceModel{
+		Interval:              types.Int64Null(),
+		Timeout:               types.Int64Null(),
+		AssignedAlertContacts: types.SetNull(alertContactObjectType()),
+		SSLExpirationReminder: types.BoolNull(),
+		FollowRedirections:    types.BoolNull(),
+		RegionData:            types.ObjectNull(regionDataObjectType().AttrTypes),
+		RegionalData:          types.StringNull(),
+		URL:                   types.StringValue(url),
+	}
+}
+
+func TestApplyMonitorDefaults_FillsNullAttributes(t *testing.T) {
+	t.Parallel()
+
+	contacts := types.SetValueMust(alertContactObjectType(), []attr.Value{
+		types.ObjectValueMust(alertContactObjectType().AttrTypes, map[string]attr.Value{
+			"alert_contact_id": types.StringValue("7"),
+			"threshold":        types.Int64Value(0),
+			"recurrence":       types.Int64Value(0),
+		}),
+	})
+	defaults := &monitorDefaultsTF{
+		Interval:              types.Int64Value(300),
+		Timeout:               types.Int64Value(20),
+		AssignedAlertContacts: contacts,
+		SSLExpirationReminder: types.BoolValue(true),
+		FollowRedirections:    types.BoolValue(true),
+		RegionData:            types.ObjectNull(regionDataObjectType().AttrTypes),
+	}
+
+	config := nullMonitorDefaultsConfig("https://example.com")
+	config.Timeout = types.Int64Value(10)
+	plan := config
+	applied := applyMonitorDefaults(defaults, MonitorTypeHTTP, config, &plan)
+
+	want := []string{"interval", "assigned_alert_contacts", "ssl_expiration_reminder", "follow_redirections"}
+	if len(applied) != len(want) {
+		t.Fatalf("expected %v, got %v", want, applied)
+	}
+	for i := range want {
+		if applied[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, applied)
+		}
+	}
+	if plan.Interval.ValueInt64() != 300 || plan.Timeout.ValueInt64() != 10 {
+		t.Fatalf("expected interval default and configured timeout, got %v %v", plan.Interval, plan.Timeout)
+	}
+	if !plan.AssignedAlertContacts.Equal(contacts) {
+		t.Fatalf("expected default alert contacts, got %v", plan.AssignedAlertContacts)
+	}
+}
+
+func TestApplyMonitorDefaults_SkipsAttributesOutsideMonitorType(t *testing.T) {
+	t.Parallel()
+
+	defaults := &monitorDefaultsTF{
+		Interval:              types.Int64Null(),
+		Timeout:               types.Int64Value(20),
+		AssignedAlertContacts: types.SetNull(alertContactObjectType()),
+		SSLExpirationReminder: types.BoolValue(true),
+		FollowRedirections:    types.BoolValue(true),
+		RegionData:            types.ObjectNull(regionDataObjectType().AttrTypes),
+	}
+
+	config := nullMonitorDefaultsConfig("heartbeat")
+	plan := config
+	plan.AssignedAlertContacts = types.SetUnknown(alertContactObjectType())
+	if applied := applyMonitorDefaults(defaults, MonitorTypeHEARTBEAT, config, &plan); len(applied) != 0 {
+		t.Fatalf("expected no defaults for a heartbeat monitor, got %v", applied)
+	}
+	if !plan.AssignedAlertContacts.IsNull() {
+		t.Fatalf("expected unmanaged alert contacts to stay null, got %v", plan.AssignedAlertContacts)
+	}
+
+	config = nullMonitorDefaultsConfig("http://example.com")
+	plan = config
+	applied := applyMonitorDefaults(defaults, MonitorTypeHTTP, config, &plan)
+	for _, name := range applied {
+		if name == "ssl_expiration_reminder" {
+			t.Fatalf("expected ssl_expiration_reminder to be skipped for an http:// URL, got %v", applied)
+		}
+	}
+}
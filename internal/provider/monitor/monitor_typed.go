@@ -0,0 +1,686 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// monitorResourceTypeName is the type name of the generic monitor resource,
+// which the typed monitor resources accept moved blocks from.
+const (
+	monitorResourceTypeName = "uptimerobot_monitor"
+	providerAddress         = "registry.terraform.io/uptimerobot/uptimerobot"
+)
+
+// typedMonitorSpec describes one of the per-type monitor resources. Each one
+// exposes only the attributes that apply to its monitor type and delegates
+// everything else to monitorResource.
+type typedMonitorSpec struct {
+	monitorType string
+	typeName    string
+	description string
+	// attributes are the root attributes besides typedMonitorCommonAttributes.
+	attributes []string
+	// required are attributes that are optional in uptimerobot_monitor but
+	// always required for this monitor type.
+	required []string
+	// config are the config attributes. Without any the config attribute is
+	// left out.
+	config []string
+	// urlDescription describes url. Without one url is computed, as for
+	// HEARTBEAT monitors.
+	urlDescription string
+}
+
+var typedMonitorCommonAttributes = []string{
+	"id",
+	"name",
+	"interval",
+	"status",
+	"is_paused",
+	"url",
+	"group_id",
+	"tags",
+	"tags_all",
+	"custom_fields",
+	"custom_fields_all",
+	"assigned_alert_contacts",
+	"maintenance_window_ids",
+	"region_data",
+	"defaults_applied",
+	"deletion_protection",
+}
+
+var typedMonitorHTTPAttributes = []string{
+	"timeout",
+	"response_time_threshold",
+	"domain_expiration_reminder",
+	"ssl_expiration_reminder",
+	"check_ssl_errors",
+	"follow_redirections",
+	"auth_type",
+	"http_username",
+	"http_password",
+	"http_password_wo",
+	"http_password_wo_version",
+	"custom_http_headers",
+	"custom_http_headers_wo",
+	"custom_http_headers_wo_version",
+	"http_method_type",
+	"success_http_response_codes",
+	"post_value_type",
+	"post_value_data",
+	"post_value_kv",
+}
+
+var typedMonitorHTTPConfig = []string{
+	"ssl_expiration_period_days",
+	"ip_version",
+	"application_error_retries",
+}
+
+var typedMonitorNetworkAttributes = []string{
+	"timeout",
+	"response_time_threshold",
+	"domain_expiration_reminder",
+}
+
+var typedMonitorSpecs = map[string]typedMonitorSpec{
+	MonitorTypeHTTP: {
+		monitorType:    MonitorTypeHTTP,
+		typeName:       "http_monitor",
+		description:    "Manages an UptimeRobot HTTP(s) monitor.",
+		attributes:     typedMonitorHTTPAttributes,
+		required:       []string{"url"},
+		config:         typedMonitorHTTPConfig,
+		urlDescription: "URL to monitor. Must be an http:// or https:// URL.",
+	},
+	MonitorTypeKEYWORD: {
+		monitorType:    MonitorTypeKEYWORD,
+		typeName:       "keyword_monitor",
+		description:    "Manages an UptimeRobot keyword monitor, which alerts when a keyword exists or is missing in the response.",
+		attributes:     append([]string{"keyword_value", "keyword_type", "keyword_case_type"}, typedMonitorHTTPAttributes...),
+		required:       []string{"url", "keyword_value", "keyword_type", "keyword_case_type"},
+		config:         typedMonitorHTTPConfig,
+		urlDescription: "URL to monitor. Must be an http:// or https:// URL.",
+	},
+	MonitorTypeAPI: {
+		monitorType:    MonitorTypeAPI,
+		typeName:       "api_monitor",
+		description:    "Manages an UptimeRobot API monitor, which checks the JSON response with assertions.",
+		attributes:     typedMonitorHTTPAttributes,
+		required:       []string{"url"},
+		config:         append([]string{"api_assertions"}, typedMonitorHTTPConfig...),
+		urlDescription: "URL to monitor. Must be an http:// or https:// URL.",
+	},
+	MonitorTypePING: {
+		monitorType:    MonitorTypePING,
+		typeName:       "ping_monitor",
+		description:    "Manages an UptimeRobot ping monitor.",
+		attributes:     typedMonitorNetworkAttributes,
+		required:       []string{"url"},
+		config:         []string{"ip_version"},
+		urlDescription: "Hostname or IP address to ping.",
+	},
+	MonitorTypePORT: {
+		monitorType:    MonitorTypePORT,
+		typeName:       "port_monitor",
+		description:    "Manages an UptimeRobot port monitor.",
+		attributes:     append([]string{"port"}, typedMonitorNetworkAttributes...),
+		required:       []string{"url", "port"},
+		config:         []string{"ip_version"},
+		urlDescription: "Hostname or IP address to connect to.",
+	},
+	MonitorTypeUDP: {
+		monitorType:    MonitorTypeUDP,
+		typeName:       "udp_monitor",
+		description:    "Manages an UptimeRobot UDP monitor.",
+		attributes:     append([]string{"port"}, typedMonitorNetworkAttributes...),
+		required:       []string{"url", "port"},
+		config:         []string{"udp"},
+		urlDescription: "Hostname or IP address to send UDP packets to.",
+	},
+	MonitorTypeDNS: {
+		monitorType:    MonitorTypeDNS,
+		typeName:       "dns_monitor",
+		description:    "Manages an UptimeRobot DNS monitor.",
+		attributes:     []string{"response_time_threshold", "domain_expiration_reminder"},
+		required:       []string{"url"},
+		config:         []string{"dns_records"},
+		urlDescription: "Domain name to resolve.",
+	},
+	MonitorTypeHEARTBEAT: {
+		monitorType: MonitorTypeHEARTBEAT,
+		typeName:    "heartbeat_monitor",
+		description: "Manages an UptimeRobot heartbeat monitor, which expects regular requests to its generated URL.",
+		attributes:  []string{"grace_period"},
+		required:    []string{"grace_period"},
+	},
+}
+
+// NewHTTPResource returns the uptimerobot_http_monitor resource.
+func NewHTTPResource() resource.Resource { return newTypedResource(MonitorTypeHTTP) }
+
+// NewKeywordResource returns the uptimerobot_keyword_monitor resource.
+func NewKeywordResource() resource.Resource { return newTypedResource(MonitorTypeKEYWORD) }
+
+// NewAPIResource returns the uptimerobot_api_monitor resource.
+func NewAPIResource() resource.Resource { return newTypedResource(MonitorTypeAPI) }
+
+// NewPingResource returns the uptimerobot_ping_monitor resource.
+func NewPingResource() resource.Resource { return newTypedResource(MonitorTypePING) }
+
+// NewPortResource returns the uptimerobot_port_monitor resource.
+func NewPortResource() resource.Resource { return newTypedResource(MonitorTypePORT) }
+
+// NewUDPResource returns the uptimerobot_udp_monitor resource.
+func NewUDPResource() resource.Resource { return newTypedResource(MonitorTypeUDP) }
+
+// NewDNSResource returns the uptimerobot_dns_monitor resource.
+func NewDNSResource() resource.Resource { return newTypedResource(MonitorTypeDNS) }
+
+// NewHeartbeatResource returns the uptimerobot_heartbeat_monitor resource.
+func NewHeartbeatResource() resource.Resource { return newTypedResource(MonitorTypeHEARTBEAT) }
+
+func newTypedResource(monitorType string) *typedMonitorResource {
+	return &typedMonitorResource{
+		monitor: &monitorResource{},
+		spec:    typedMonitorSpecs[monitorType],
+	}
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &typedMonitorResource{}
+	_ resource.ResourceWithConfigure        = &typedMonitorResource{}
+	_ resource.ResourceWithModifyPlan       = &typedMonitorResource{}
+	_ resource.ResourceWithImportState      = &typedMonitorResource{}
+	_ resource.ResourceWithMoveState        = &typedMonitorResource{}
+	_ resource.ResourceWithConfigValidators = &typedMonitorResource{}
+	_ resource.ResourceWithValidateConfig   = &typedMonitorResource{}
+)
+
+// typedMonitorResource is a monitor resource for a single monitor type. It
+// converts its narrow plan, state and config to the uptimerobot_monitor
+// schema, so validation, planning and the client transforms are shared.
+type typedMonitorResource struct {
+	monitor *monitorResource
+	spec    typedMonitorSpec
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *typedMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.monitor.Configure(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *typedMonitorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.spec.typeName
+}
+
+// Schema defines the schema for the resource.
+func (r *typedMonitorResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.spec.schema(r.monitorSchema(ctx), &resp.Diagnostics)
+}
+
+func (r *typedMonitorResource) monitorSchema(ctx context.Context) schema.Schema {
+	var resp resource.SchemaResponse
+	r.monitor.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// schema returns the typed schema, cut down from the uptimerobot_monitor
+// schema. An attribute it cannot adapt is reported in diags rather than
+// crashing the plugin; TestTypedMonitorSchemas builds every typed schema.
+func (s typedMonitorSpec) schema(full schema.Schema, diags *diag.Diagnostics) schema.Schema {
+	out := schema.Schema{
+		Description: s.description,
+		Attributes:  map[string]schema.Attribute{},
+		Blocks:      full.Blocks,
+	}
+	for _, name := range append(append([]string{}, typedMonitorCommonAttributes...), s.attributes...) {
+		out.Attributes[name] = full.Attributes[name]
+	}
+	for _, name := range s.required {
+		required, ok := typedMonitorRequired(out.Attributes[name])
+		if !ok {
+			addTypedSchemaError(diags, s.typeName, name, out.Attributes[name])
+			continue
+		}
+		out.Attributes[name] = required
+	}
+
+	url, ok := out.Attributes["url"].(schema.StringAttribute)
+	if !ok {
+		addTypedSchemaError(diags, s.typeName, "url", out.Attributes["url"])
+		return out
+	}
+	url.MarkdownDescription = ""
+	if s.urlDescription != "" {
+		url.Description = s.urlDescription
+	} else {
+		url.Optional = false
+		url.Validators = nil
+		url.Description = "Heartbeat URL generated by UptimeRobot. Send requests to it to report that the monitored job is alive."
+	}
+	out.Attributes["url"] = url
+
+	if len(s.config) > 0 {
+		cfg, ok := full.Attributes["config"].(schema.SingleNestedAttribute)
+		if !ok {
+			addTypedSchemaError(diags, s.typeName, "config", full.Attributes["config"])
+			return out
+		}
+		attrs := make(map[string]schema.Attribute, len(s.config))
+		for _, name := range s.config {
+			attrs[name] = cfg.Attributes[name]
+		}
+		cfg.Attributes = attrs
+		cfg.Description = "Advanced monitor configuration. Mirrors the API 'config' object."
+		cfg.MarkdownDescription = "Advanced monitor configuration. Mirrors the API `config` object.\n\n" +
+			"- Omit the attribute to preserve remote values.\n" +
+			"- `config = {}` manages the block but keeps the current remote values."
+		if s.monitorType == MonitorTypeDNS || s.monitorType == MonitorTypeAPI || s.monitorType == MonitorTypeUDP {
+			cfg.MarkdownDescription += "\n- Required on create; use `config = {}` for the API defaults."
+		}
+		cfg.PlanModifiers = []planmodifier.Object{
+			configNullIfOmitted{attrTypes: cfg.GetType().(types.ObjectType).AttrTypes},
+		}
+		out.Attributes["config"] = cfg
+	}
+
+	return out
+}
+
+// typedMonitorRequired makes an attribute that uptimerobot_monitor only
+// validates as required for some types required in the schema. It returns
+// false for attribute types it does not handle.
+func typedMonitorRequired(a schema.Attribute) (schema.Attribute, bool) {
+	switch a := a.(type) {
+	case schema.StringAttribute:
+		a.Required, a.Optional, a.Computed = true, false, false
+		a.Default, a.PlanModifiers = nil, nil
+		return a, true
+	case schema.Int64Attribute:
+		a.Required, a.Optional, a.Computed = true, false, false
+		a.Default, a.PlanModifiers = nil, nil
+		return a, true
+	default:
+		return a, false
+	}
+}
+
+func addTypedSchemaError(diags *diag.Diagnostics, typeName, name string, a schema.Attribute) {
+	diags.AddError(
+		"Unsupported monitor schema attribute",
+		fmt.Sprintf("The uptimerobot_%s schema cannot adapt attribute %q of type %T. This is a bug in the provider.", typeName, name, a),
+	)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *typedMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	full := r.monitorSchema(ctx)
+	state := r.emptyState(ctx, full)
+
+	config, diags := r.widenConfig(ctx, full, req.Config)
+	resp.Diagnostics.Append(diags...)
+	plan, diags := r.widenPlan(ctx, full, req.Plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorResp := resource.CreateResponse{State: state, Private: resp.Private}
+	r.monitor.Create(ctx, resource.CreateRequest{Config: config, Plan: plan, ProviderMeta: req.ProviderMeta}, &monitorResp)
+	resp.Diagnostics.Append(monitorResp.Diagnostics...)
+	r.narrowInto(ctx, monitorResp.State, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *typedMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	full := r.monitorSchema(ctx)
+
+	state, diags := r.widenState(ctx, full, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorResp := resource.ReadResponse{State: state, Private: resp.Private}
+	r.monitor.Read(ctx, resource.ReadRequest{State: state, Private: req.Private, ProviderMeta: req.ProviderMeta}, &monitorResp)
+	resp.Diagnostics.Append(monitorResp.Diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !monitorResp.State.Raw.IsNull() && !r.checkType(ctx, monitorResp.State, &resp.Diagnostics) {
+		return
+	}
+	r.narrowInto(ctx, monitorResp.State, &resp.State, &resp.Diagnostics)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *typedMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	full := r.monitorSchema(ctx)
+
+	state, diags := r.widenState(ctx, full, req.State)
+	resp.Diagnostics.Append(diags...)
+	config, diags := r.widenConfig(ctx, full, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan, diags := r.widenPlan(ctx, full, req.Plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorResp := resource.UpdateResponse{State: state, Private: resp.Private}
+	r.monitor.Update(ctx, resource.UpdateRequest{
+		Config:       config,
+		Plan:         plan,
+		State:        state,
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}, &monitorResp)
+	resp.Diagnostics.Append(monitorResp.Diagnostics...)
+	r.narrowInto(ctx, monitorResp.State, &resp.State, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *typedMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	full := r.monitorSchema(ctx)
+
+	state, diags := r.widenState(ctx, full, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorResp := resource.DeleteResponse{State: state, Private: resp.Private}
+	r.monitor.Delete(ctx, resource.DeleteRequest{State: state, Private: req.Private, ProviderMeta: req.ProviderMeta}, &monitorResp)
+	resp.Diagnostics.Append(monitorResp.Diagnostics...)
+}
+
+// ModifyPlan applies the uptimerobot_monitor plan adjustments.
+func (r *typedMonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	full := r.monitorSchema(ctx)
+
+	state, diags := r.widenState(ctx, full, req.State)
+	resp.Diagnostics.Append(diags...)
+	config, diags := r.widenConfig(ctx, full, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan, diags := r.widenPlan(ctx, full, req.Plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorResp := resource.ModifyPlanResponse{Plan: plan, Private: resp.Private}
+	r.monitor.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config:       config,
+		Plan:         plan,
+		State:        state,
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}, &monitorResp)
+	resp.Diagnostics.Append(monitorResp.Diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	narrowed, err := narrowMonitorValue(monitorResp.Plan.Raw, resp.Plan.Schema.Type().TerraformType(ctx).(tftypes.Object))
+	if err != nil {
+		resp.Diagnostics.AddError("Error converting monitor plan", err.Error())
+		return
+	}
+	resp.Plan.Raw = narrowed
+	for _, p := range monitorResp.RequiresReplace {
+		if steps := p.Steps(); len(steps) > 0 {
+			if name, ok := steps[0].(path.PathStepAttributeName); ok && r.spec.has(string(name)) {
+				resp.RequiresReplace.Append(p)
+			}
+		}
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *typedMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ConfigValidators returns the uptimerobot_monitor validators that apply to
+// the attributes of this monitor type.
+func (r *typedMonitorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	var validators []resource.ConfigValidator
+	if r.spec.has("post_value_data") {
+		validators = append(validators, resourcevalidator.Conflicting(
+			path.MatchRoot("post_value_data"),
+			path.MatchRoot("post_value_kv"),
+		))
+	}
+	return validators
+}
+
+// ValidateConfig runs the uptimerobot_monitor validation for this monitor type.
+func (r *typedMonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	config, diags := r.widenConfig(ctx, r.monitorSchema(ctx), req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.monitor.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+}
+
+// MoveState moves uptimerobot_monitor resources of this monitor type to the
+// typed resource, so a moved block can switch to it without replacement. This
+// covers monitors of this provider and of the community louy/uptimerobot
+// provider.
+func (r *typedMonitorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: r.moveFromMonitor},
+		{StateMover: r.moveFromLegacy},
+	}
+}
+
+func (r *typedMonitorResource) moveFromLegacy(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	full := r.monitorSchema(ctx)
+	fullResp := resource.MoveStateResponse{
+		TargetState: tfsdk.State{Schema: full, Raw: tftypes.NewValue(full.Type().TerraformType(ctx), nil)},
+	}
+	moveMonitorFromLegacy(ctx, req, &fullResp)
+	resp.Diagnostics.Append(fullResp.Diagnostics...)
+	if resp.Diagnostics.HasError() || fullResp.TargetState.Raw.IsNull() {
+		return
+	}
+	if !r.checkType(ctx, fullResp.TargetState, &resp.Diagnostics) {
+		return
+	}
+	r.narrowInto(ctx, fullResp.TargetState, &resp.TargetState, &resp.Diagnostics)
+}
+
+func (r *typedMonitorResource) moveFromMonitor(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != monitorResourceTypeName || req.SourceProviderAddress != providerAddress {
+		return
+	}
+
+	full := r.monitorSchema(ctx)
+	source, diags := r.monitorStateFromRaw(ctx, full, req.SourceSchemaVersion, req.SourceRawState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.checkType(ctx, source, &resp.Diagnostics) {
+		return
+	}
+	r.narrowInto(ctx, source, &resp.TargetState, &resp.Diagnostics)
+}
+
+// monitorStateFromRaw decodes uptimerobot_monitor state of any schema version
+// into the current schema, running the state upgrader for older versions.
+func (r *typedMonitorResource) monitorStateFromRaw(
+	ctx context.Context,
+	full schema.Schema,
+	version int64,
+	raw *tfprotov6.RawState,
+) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := r.emptyState(ctx, full)
+	if raw == nil {
+		diags.AddError("Unable to move monitor", "The source monitor has no state.")
+		return state, diags
+	}
+
+	if version == full.Version {
+		v, err := raw.UnmarshalWithOpts(full.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+			ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+		})
+		if err != nil {
+			diags.AddError("Unable to move monitor", "Could not decode the source monitor state: "+err.Error())
+			return state, diags
+		}
+		state.Raw = v
+		return state, diags
+	}
+
+	upgrader, ok := r.monitor.UpgradeState(ctx)[version]
+	if !ok || upgrader.PriorSchema == nil {
+		diags.AddError(
+			"Unable to move monitor",
+			fmt.Sprintf("uptimerobot_monitor schema version %d is not supported. Apply the configuration once with this provider version before moving the monitor.", version),
+		)
+		return state, diags
+	}
+	v, err := raw.UnmarshalWithOpts(upgrader.PriorSchema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	})
+	if err != nil {
+		diags.AddError("Unable to move monitor", "Could not decode the source monitor state: "+err.Error())
+		return state, diags
+	}
+
+	upgradeResp := resource.UpgradeStateResponse{State: state}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+		RawState: raw,
+		State:    &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: v},
+	}, &upgradeResp)
+	diags.Append(upgradeResp.Diagnostics...)
+	return upgradeResp.State, diags
+}
+
+// checkType reports whether the monitor in state has the type of this
+// resource, adding an error when it does not.
+func (r *typedMonitorResource) checkType(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) bool {
+	var t types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("type"), &t)...)
+	if diags.HasError() {
+		return false
+	}
+	if strings.EqualFold(t.ValueString(), r.spec.monitorType) {
+		return true
+	}
+
+	var id types.String
+	_ = state.GetAttribute(ctx, path.Root("id"), &id)
+	diags.AddError(
+		"Monitor type mismatch",
+		fmt.Sprintf(
+			"Monitor %s is a %s monitor, but %s only manages %s monitors. Use %s instead.",
+			id.ValueString(), t.ValueString(), r.spec.resourceName(), r.spec.monitorType, typedMonitorResourceFor(t.ValueString()),
+		),
+	)
+	return false
+}
+
+// has reports whether the typed schema includes the root attribute name.
+func (s typedMonitorSpec) has(name string) bool {
+	if name == "config" {
+		return len(s.config) > 0
+	}
+	for _, n := range s.attributes {
+		if n == name {
+			return true
+		}
+	}
+	for _, n := range typedMonitorCommonAttributes {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceName returns the resource type name, for diagnostics.
+func (s typedMonitorSpec) resourceName() string {
+	return "uptimerobot_" + s.typeName
+}
+
+// typedMonitorResourceFor names the resource that manages monitors of
+// monitorType, falling back to uptimerobot_monitor.
+func typedMonitorResourceFor(monitorType string) string {
+	if s, ok := typedMonitorSpecs[strings.ToUpper(monitorType)]; ok {
+		return s.resourceName()
+	}
+	return monitorResourceTypeName
+}
+
+func (r *typedMonitorResource) emptyState(ctx context.Context, full schema.Schema) tfsdk.State {
+	return tfsdk.State{Schema: full, Raw: tftypes.NewValue(full.Type().TerraformType(ctx), nil)}
+}
+
+func (r *typedMonitorResource) widenConfig(ctx context.Context, full schema.Schema, config tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	v, diags := r.widen(ctx, full, config.Raw, typedMonitorFillConfig(r.spec.monitorType))
+	return tfsdk.Config{Schema: full, Raw: v}, diags
+}
+
+func (r *typedMonitorResource) widenState(ctx context.Context, full schema.Schema, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	fill := typedMonitorFillState(r.spec.monitorType, typedMonitorIsImport(ctx, state))
+	v, diags := r.widen(ctx, full, state.Raw, fill)
+	return tfsdk.State{Schema: full, Raw: v}, diags
+}
+
+func (r *typedMonitorResource) widenPlan(ctx context.Context, full schema.Schema, plan tfsdk.Plan, state tfsdk.State) (tfsdk.Plan, diag.Diagnostics) {
+	v, diags := r.widen(ctx, full, plan.Raw, typedMonitorFillPlan(r.spec.monitorType, state.Raw))
+	return tfsdk.Plan{Schema: full, Raw: v}, diags
+}
+
+func (r *typedMonitorResource) widen(ctx context.Context, full schema.Schema, v tftypes.Value, fill typedMonitorFill) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	typ := full.Type().TerraformType(ctx).(tftypes.Object)
+	if v.Type() == nil {
+		return tftypes.NewValue(typ, nil), diags
+	}
+	out, err := widenMonitorValue(ctx, v, typ, full.Attributes, fill, nil)
+	if err != nil {
+		diags.AddError("Error converting monitor data", err.Error())
+	}
+	return out, diags
+}
+
+// narrowInto converts uptimerobot_monitor state to the typed schema of target.
+func (r *typedMonitorResource) narrowInto(ctx context.Context, state tfsdk.State, target *tfsdk.State, diags *diag.Diagnostics) {
+	v, err := narrowMonitorValue(state.Raw, target.Schema.Type().TerraformType(ctx).(tftypes.Object))
+	if err != nil {
+		diags.AddError("Error converting monitor state", err.Error())
+		return
+	}
+	target.Raw = v
+}
@@ -0,0 +1,368 @@
+package monitor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+func testTypedSchema(t *testing.T, r *typedMonitorResource) schema.Schema {
+	t.Helper()
+
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+func testTypedState(t *testing.T, s schema.Schema, values map[string]any) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	for name, v := range values {
+		if diags := state.SetAttribute(ctx, path.Root(name), v); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+	return state
+}
+
+func TestTypedMonitorSchemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	httpAttrs := testTypedSchema(t, newTypedResource(MonitorTypeHTTP)).Attributes
+	var fullResp resource.SchemaResponse
+	(&monitorResource{}).Schema(ctx, resource.SchemaRequest{}, &fullResp)
+
+	for monitorType, spec := range typedMonitorSpecs {
+		spec := spec
+		t.Run(monitorType, func(t *testing.T) {
+			t.Parallel()
+
+			s := testTypedSchema(t, newTypedResource(monitorType))
+			if diags := s.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("invalid schema: %v", diags)
+			}
+			if _, ok := s.Attributes["type"]; ok {
+				t.Fatal("typed schema must not have a type attribute")
+			}
+			if _, ok := s.Attributes["regional_data"]; ok {
+				t.Fatal("typed schema must not have the deprecated regional_data attribute")
+			}
+			for name := range s.Attributes {
+				if _, ok := fullResp.Schema.Attributes[name]; !ok {
+					t.Fatalf("attribute %q is not an uptimerobot_monitor attribute", name)
+				}
+			}
+			for _, name := range spec.required {
+				if !s.Attributes[name].IsRequired() {
+					t.Fatalf("expected %q to be required", name)
+				}
+			}
+			if _, ok := s.Attributes["config"]; ok != (len(spec.config) > 0) {
+				t.Fatalf("expected config presence %t", len(spec.config) > 0)
+			}
+		})
+	}
+
+	if _, ok := httpAttrs["keyword_value"]; ok {
+		t.Fatal("uptimerobot_http_monitor must not accept keyword_value")
+	}
+	if _, ok := httpAttrs["grace_period"]; ok {
+		t.Fatal("uptimerobot_http_monitor must not accept grace_period")
+	}
+
+	heartbeat := testTypedSchema(t, newTypedResource(MonitorTypeHEARTBEAT)).Attributes
+	if url := heartbeat["url"]; url.IsOptional() || !url.IsComputed() {
+		t.Fatal("expected the heartbeat url to be computed only")
+	}
+	if _, ok := heartbeat["timeout"]; ok {
+		t.Fatal("uptimerobot_heartbeat_monitor must not accept timeout")
+	}
+
+	dns := testTypedSchema(t, newTypedResource(MonitorTypeDNS)).Attributes
+	cfg := dns["config"].(schema.SingleNestedAttribute)
+	if len(cfg.Attributes) != 1 || cfg.Attributes["dns_records"] == nil {
+		t.Fatalf("expected the DNS config to only have dns_records, got %v", cfg.Attributes)
+	}
+}
+
+func TestTypedMonitorSchema_UnsupportedRequiredAttribute(t *testing.T) {
+	t.Parallel()
+
+	var fullResp resource.SchemaResponse
+	(&monitorResource{}).Schema(context.Background(), resource.SchemaRequest{}, &fullResp)
+
+	spec := typedMonitorSpecs[MonitorTypeHTTP]
+	spec.required = append(append([]string{}, spec.required...), "tags")
+	var diags diag.Diagnostics
+	spec.schema(fullResp.Schema, &diags)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), `"tags"`) {
+		t.Fatalf("expected an error for the unsupported attribute, got %v", diags)
+	}
+}
+
+func TestTypedMonitorWidenAndNarrow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := newTypedResource(MonitorTypePING)
+	full := r.monitorSchema(ctx)
+	s := testTypedSchema(t, r)
+
+	narrow := testTypedState(t, s, map[string]any{
+		"id":       types.StringValue("1"),
+		"name":     types.StringValue("gateway"),
+		"url":      types.StringValue("gateway.example.com"),
+		"interval": types.Int64Value(300),
+	})
+
+	plan, diags := r.widenPlan(ctx, full, tfsdk.Plan(narrow), r.emptyState(ctx, full))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var got monitorResourceModel
+	if diags := plan.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("reading widened plan: %v", diags)
+	}
+	if got.Type.ValueString() != MonitorTypePING {
+		t.Fatalf("expected type PING, got %s", got.Type)
+	}
+	if got.AuthType.ValueString() != "HTTP_BASIC" || got.FollowRedirections.ValueBool() {
+		t.Fatalf("expected schema defaults for omitted attributes, got auth_type %s and follow_redirections %s", got.AuthType, got.FollowRedirections)
+	}
+	if !got.HTTPMethodType.IsUnknown() {
+		t.Fatalf("expected omitted computed attributes to be unknown on create, got %s", got.HTTPMethodType)
+	}
+	if !got.Config.IsNull() || !got.KeywordValue.IsNull() {
+		t.Fatalf("expected omitted config and keyword_value to be null, got %s and %s", got.Config, got.KeywordValue)
+	}
+
+	back, err := narrowMonitorValue(plan.Raw, s.Type().TerraformType(ctx).(tftypes.Object))
+	if err != nil {
+		t.Fatalf("narrowing: %v", err)
+	}
+	if !back.Equal(narrow.Raw) {
+		t.Fatalf("expected the round trip to keep the value\nwant %s\ngot  %s", narrow.Raw, back)
+	}
+
+	// On update omitted attributes keep their prior state.
+	state, diags := r.widenState(ctx, full, narrow)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	plan, diags = r.widenPlan(ctx, full, tfsdk.Plan(narrow), state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if diags := plan.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("reading widened plan: %v", diags)
+	}
+	if !got.HTTPMethodType.IsNull() {
+		t.Fatalf("expected omitted attributes to keep prior state on update, got %s", got.HTTPMethodType)
+	}
+}
+
+func TestTypedMonitorWidenState_ImportLeavesTypeNull(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := newTypedResource(MonitorTypeHTTP)
+	full := r.monitorSchema(ctx)
+	imported := testTypedState(t, testTypedSchema(t, r), map[string]any{"id": types.StringValue("1")})
+
+	state, diags := r.widenState(ctx, full, imported)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var got monitorResourceModel
+	if diags := state.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("reading widened state: %v", diags)
+	}
+	if !readIsImport(got) {
+		t.Fatalf("expected Read to recognize the import, got type %s", got.Type)
+	}
+}
+
+func TestTypedMonitorMoveState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	raw := func(json string) *tfprotov6.RawState { return &tfprotov6.RawState{JSON: []byte(json)} }
+	const httpMonitor = `{"id":"1","type":"HTTP","name":"website","url":"https://example.com","interval":300,"keyword_value":null}`
+
+	tests := []struct {
+		name         string
+		typ          string
+		sourceType   string
+		address      string
+		version      int64
+		state        string
+		wantMoved    bool
+		wantErrorSub string
+	}{
+		{
+			name:       "current schema",
+			typ:        MonitorTypeHTTP,
+			sourceType: monitorResourceTypeName,
+			address:    providerAddress,
+			version:    6,
+			state:      httpMonitor,
+			wantMoved:  true,
+		},
+		{
+			name:       "older schema is upgraded",
+			typ:        MonitorTypeHTTP,
+			sourceType: monitorResourceTypeName,
+			address:    providerAddress,
+			version:    5,
+			state:      httpMonitor,
+			wantMoved:  true,
+		},
+		{
+			name:         "other monitor type",
+			typ:          MonitorTypeKEYWORD,
+			sourceType:   monitorResourceTypeName,
+			address:      providerAddress,
+			version:      6,
+			state:        httpMonitor,
+			wantErrorSub: "uptimerobot_http_monitor",
+		},
+		{
+			name:       "other resource type",
+			typ:        MonitorTypeHTTP,
+			sourceType: "uptimerobot_psp",
+			address:    providerAddress,
+			version:    6,
+			state:      httpMonitor,
+		},
+		{
+			name:       "other provider",
+			typ:        MonitorTypeHTTP,
+			sourceType: monitorResourceTypeName,
+			address:    "registry.terraform.io/example/uptimerobot",
+			version:    6,
+			state:      httpMonitor,
+		},
+		{
+			name:         "unknown schema version",
+			typ:          MonitorTypeHTTP,
+			sourceType:   monitorResourceTypeName,
+			address:      providerAddress,
+			version:      42,
+			state:        httpMonitor,
+			wantErrorSub: "schema version 42",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newTypedResource(tt.typ)
+			s := testTypedSchema(t, r)
+			resp := resource.MoveStateResponse{
+				TargetState: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}
+			r.moveFromMonitor(ctx, resource.MoveStateRequest{
+				SourceProviderAddress: tt.address,
+				SourceTypeName:        tt.sourceType,
+				SourceSchemaVersion:   tt.version,
+				SourceRawState:        raw(tt.state),
+			}, &resp)
+
+			if tt.wantErrorSub != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.wantErrorSub) {
+					t.Fatalf("expected an error mentioning %q, got %v", tt.wantErrorSub, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.TargetState.Raw.IsNull() == tt.wantMoved {
+				t.Fatalf("expected moved %t, got state %s", tt.wantMoved, resp.TargetState.Raw)
+			}
+			if !tt.wantMoved {
+				return
+			}
+			var name types.String
+			resp.TargetState.GetAttribute(ctx, path.Root("name"), &name)
+			if name.ValueString() != "website" {
+				t.Fatalf("expected the moved state to keep the name, got %s", name)
+			}
+		})
+	}
+}
+
+func TestTypedMonitorRead_ChecksType(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		remoteType string
+		wantError  bool
+	}{
+		"matching type": {remoteType: "HTTP"},
+		"other type":    {remoteType: "KEYWORD", wantError: true},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.Method+" "+req.URL.Path != "GET /monitors/1" {
+					t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				_, _ = w.Write([]byte(`{"id":1,"friendlyName":"website","type":"` + tt.remoteType +
+					`","url":"https://example.com","interval":300,"status":"UP","timeout":30}`))
+			}))
+			defer srv.Close()
+
+			apiClient := client.NewClient("test-key")
+			apiClient.SetBaseURL(srv.URL)
+			r := newTypedResource(MonitorTypeHTTP)
+			r.monitor.client = apiClient
+
+			ctx := context.Background()
+			state := testTypedState(t, testTypedSchema(t, r), map[string]any{"id": types.StringValue("1")})
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+
+			if tt.wantError {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Monitor type mismatch" {
+					t.Fatalf("expected a type mismatch error, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			var url types.String
+			resp.State.GetAttribute(ctx, path.Root("url"), &url)
+			if url.ValueString() != "https://example.com" {
+				t.Fatalf("expected the read url in state, got %s", url)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The typed monitor resources reuse the uptimerobot_monitor implementation by
+// converting their plan, state and config to the full monitor schema and
+// back. Attributes a typed schema leaves out are filled the way Terraform
+// would have planned them had they been omitted from the configuration.
+
+// typedMonitorFill returns the value of an attribute the typed schema does not
+// have. names is the attribute path from the root, for example
+// ["config", "udp"].
+type typedMonitorFill func(ctx context.Context, names []string, a schema.Attribute, t tftypes.Type) (tftypes.Value, error)
+
+// widenMonitorValue converts v, a value of a typed schema, to typ, the type of
+// the full monitor schema. attrs are the full schema attributes at this level.
+func widenMonitorValue(
+	ctx context.Context,
+	v tftypes.Value,
+	typ tftypes.Object,
+	attrs map[string]schema.Attribute,
+	fill typedMonitorFill,
+	names []string,
+) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var narrow map[string]tftypes.Value
+	if err := v.As(&narrow); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	out := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrNames := append(append([]string{}, names...), name)
+		nv, ok := narrow[name]
+		switch {
+		case !ok:
+			fv, err := fill(ctx, attrNames, attrs[name], attrType)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			out[name] = fv
+		case nv.Type().Equal(attrType):
+			out[name] = nv
+		default:
+			nested, ok := attrs[name].(schema.SingleNestedAttribute)
+			objType, isObj := attrType.(tftypes.Object)
+			if !ok || !isObj {
+				return tftypes.Value{}, fmt.Errorf("attribute %q has a different type in the typed schema", name)
+			}
+			wv, err := widenMonitorValue(ctx, nv, objType, nested.Attributes, fill, attrNames)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			out[name] = wv
+		}
+	}
+	return tftypes.NewValue(typ, out), nil
+}
+
+// narrowMonitorValue converts v, a value of the full monitor schema, to typ,
+// the type of a typed schema, dropping the attributes typ does not have.
+func narrowMonitorValue(v tftypes.Value, typ tftypes.Object) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var wide map[string]tftypes.Value
+	if err := v.As(&wide); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	out := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		wv, ok := wide[name]
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("attribute %q is missing from the monitor value", name)
+		}
+		if wv.Type().Equal(attrType) {
+			out[name] = wv
+			continue
+		}
+		objType, ok := attrType.(tftypes.Object)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("attribute %q has a different type in the typed schema", name)
+		}
+		nv, err := narrowMonitorValue(wv, objType)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		out[name] = nv
+	}
+	return tftypes.NewValue(typ, out), nil
+}
+
+// typedMonitorFillConfig fills attributes missing from configuration. The
+// monitor type is the only one a typed resource ever sets.
+func typedMonitorFillConfig(monitorType string) typedMonitorFill {
+	return func(_ context.Context, names []string, _ schema.Attribute, t tftypes.Type) (tftypes.Value, error) {
+		if isTypedMonitorTypePath(names) {
+			return tftypes.NewValue(tftypes.String, monitorType), nil
+		}
+		return tftypes.NewValue(t, nil), nil
+	}
+}
+
+// typedMonitorFillState fills attributes missing from state with their schema
+// default. An imported monitor only has its ID until the first read, and
+// readIsImport recognizes that by the missing type, so imported is passed to
+// leave the type null.
+func typedMonitorFillState(monitorType string, imported bool) typedMonitorFill {
+	return func(ctx context.Context, names []string, a schema.Attribute, t tftypes.Type) (tftypes.Value, error) {
+		if isTypedMonitorTypePath(names) {
+			if imported {
+				return tftypes.NewValue(tftypes.String, nil), nil
+			}
+			return tftypes.NewValue(tftypes.String, monitorType), nil
+		}
+		if v, ok, err := typedMonitorDefault(ctx, names, a); ok || err != nil {
+			return v, err
+		}
+		return tftypes.NewValue(t, nil), nil
+	}
+}
+
+// typedMonitorFillPlan fills attributes missing from a plan. On update they
+// keep their prior state, so the monitor implementation never sees a change
+// to an attribute the typed schema cannot express. On create they get their
+// schema default, or unknown when computed.
+func typedMonitorFillPlan(monitorType string, prior tftypes.Value) typedMonitorFill {
+	return func(ctx context.Context, names []string, a schema.Attribute, t tftypes.Type) (tftypes.Value, error) {
+		if isTypedMonitorTypePath(names) {
+			return tftypes.NewValue(tftypes.String, monitorType), nil
+		}
+		// configNullIfOmitted plans an omitted config as null.
+		if len(names) == 1 && names[0] == "config" {
+			return tftypes.NewValue(t, nil), nil
+		}
+		if !prior.IsNull() {
+			p := tftypes.NewAttributePath()
+			for _, name := range names {
+				p = p.WithAttributeName(name)
+			}
+			if v, _, err := tftypes.WalkAttributePath(prior, p); err == nil {
+				if pv, ok := v.(tftypes.Value); ok {
+					return pv, nil
+				}
+			}
+		}
+		if v, ok, err := typedMonitorDefault(ctx, names, a); ok || err != nil {
+			return v, err
+		}
+		if a != nil && a.IsComputed() {
+			return tftypes.NewValue(t, tftypes.UnknownValue), nil
+		}
+		return tftypes.NewValue(t, nil), nil
+	}
+}
+
+func isTypedMonitorTypePath(names []string) bool {
+	return len(names) == 1 && names[0] == "type"
+}
+
+// typedMonitorDefault evaluates the schema default of a, if it has one.
+func typedMonitorDefault(ctx context.Context, names []string, a schema.Attribute) (tftypes.Value, bool, error) {
+	p := path.Empty()
+	for _, name := range names {
+		p = p.AtName(name)
+	}
+
+	var v attr.Value
+	switch a := a.(type) {
+	case schema.BoolAttribute:
+		if a.Default == nil {
+			return tftypes.Value{}, false, nil
+		}
+		var resp defaults.BoolResponse
+		a.Default.DefaultBool(ctx, defaults.BoolRequest{Path: p}, &resp)
+		if resp.Diagnostics.HasError() {
+			return tftypes.Value{}, false, fmt.Errorf("default for %s: %v", p, resp.Diagnostics)
+		}
+		v = resp.PlanValue
+	case schema.StringAttribute:
+		if a.Default == nil {
+			return tftypes.Value{}, false, nil
+		}
+		var resp defaults.StringResponse
+		a.Default.DefaultString(ctx, defaults.StringRequest{Path: p}, &resp)
+		if resp.Diagnostics.HasError() {
+			return tftypes.Value{}, false, fmt.Errorf("default for %s: %v", p, resp.Diagnostics)
+		}
+		v = resp.PlanValue
+	default:
+		return tftypes.Value{}, false, nil
+	}
+
+	tv, err := v.ToTerraformValue(ctx)
+	if err != nil {
+		return tftypes.Value{}, false, err
+	}
+	return tv, true, nil
+}
+
+// typedMonitorIsImport reports whether state is the ID-only state that import
+// leaves for the first read.
+func typedMonitorIsImport(ctx context.Context, state tfsdk.State) bool {
+	if state.Raw.IsNull() {
+		return false
+	}
+	var name, url types.String
+	var interval types.Int64
+	if d := state.GetAttribute(ctx, path.Root("name"), &name); d.HasError() {
+		return false
+	}
+	if d := state.GetAttribute(ctx, path.Root("interval"), &interval); d.HasError() {
+		return false
+	}
+	if d := state.GetAttribute(ctx, path.Root("url"), &url); d.HasError() {
+		return false
+	}
+	return name.IsNull() && interval.IsNull() && url.IsNull()
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 )
 
 // V0 -> to V1
@@ -101,6 +102,7 @@ func upgradeMonitorFromV0(ctx context.Context, prior monitorV0Model) (monitorRes
 		HTTPUsername:             prior.HTTPUsername,
 		HTTPPassword:             prior.HTTPPassword,
 		CustomHTTPHeaders:        prior.CustomHTTPHeaders,
+		CustomHTTPHeadersWO:      types.MapNull(types.StringType),
 		HTTPMethodType:           prior.HTTPMethodType,
 		SuccessHTTPResponseCodes: codesSet,
 		Timeout:                  prior.Timeout,
@@ -117,11 +119,15 @@ func upgradeMonitorFromV0(ctx context.Context, prior monitorV0Model) (monitorRes
 		Status:                   prior.Status,
 		URL:                      prior.URL,
 		Tags:                     tagsToSet, // list -> set
+		TagsAll:                  types.SetNull(types.StringType),
+		CustomFieldsAll:          types.MapNull(types.StringType),
+		DefaultsApplied:          types.SetNull(types.StringType),
 		AssignedAlertContacts:    acSet,
 		ResponseTimeThreshold:    prior.ResponseTimeThreshold,
 		RegionalData:             prior.RegionalData,
 		RegionData:               types.ObjectNull(regionDataObjectType().AttrTypes),
 		Config:                   types.ObjectNull(configObjectType().AttrTypes),
+		Timeouts:                 optimeout.Null(),
 	}
 
 	up.PostValueKV = types.MapNull(types.StringType)
@@ -392,6 +398,7 @@ func upgradeMonitorFromV1(ctx context.Context, prior monitorV1Model) (monitorRes
 		HTTPUsername:             prior.HTTPUsername,
 		HTTPPassword:             prior.HTTPPassword,
 		CustomHTTPHeaders:        prior.CustomHTTPHeaders,
+		CustomHTTPHeadersWO:      types.MapNull(types.StringType),
 		HTTPMethodType:           prior.HTTPMethodType,
 		SuccessHTTPResponseCodes: codesSet,
 		Timeout:                  prior.Timeout,
@@ -408,11 +415,15 @@ func upgradeMonitorFromV1(ctx context.Context, prior monitorV1Model) (monitorRes
 		Status:                   prior.Status,
 		URL:                      prior.URL,
 		Tags:                     prior.Tags,
+		TagsAll:                  types.SetNull(types.StringType),
+		CustomFieldsAll:          types.MapNull(types.StringType),
+		DefaultsApplied:          types.SetNull(types.StringType),
 		AssignedAlertContacts:    acSet,
 		ResponseTimeThreshold:    prior.ResponseTimeThreshold,
 		RegionalData:             prior.RegionalData,
 		RegionData:               types.ObjectNull(regionDataObjectType().AttrTypes),
 		Config:                   types.ObjectNull(configObjectType().AttrTypes),
+		Timeouts:                 optimeout.Null(),
 	}
 
 	up.PostValueKV = types.MapNull(types.StringType)
@@ -678,6 +689,7 @@ func upgradeMonitorFromV2(ctx context.Context, prior monitorV2Model) (monitorRes
 		HTTPUsername:             prior.HTTPUsername,
 		HTTPPassword:             prior.HTTPPassword,
 		CustomHTTPHeaders:        prior.CustomHTTPHeaders,
+		CustomHTTPHeadersWO:      types.MapNull(types.StringType),
 		HTTPMethodType:           prior.HTTPMethodType,
 		SuccessHTTPResponseCodes: codesSet,
 		Timeout:                  prior.Timeout,
@@ -694,11 +706,15 @@ func upgradeMonitorFromV2(ctx context.Context, prior monitorV2Model) (monitorRes
 		Status:                   prior.Status,
 		URL:                      prior.URL,
 		Tags:                     prior.Tags,
+		TagsAll:                  types.SetNull(types.StringType),
+		CustomFieldsAll:          types.MapNull(types.StringType),
+		DefaultsApplied:          types.SetNull(types.StringType),
 		AssignedAlertContacts:    acSet, // converted
 		ResponseTimeThreshold:    prior.ResponseTimeThreshold,
 		RegionalData:             prior.RegionalData,
 		RegionData:               types.ObjectNull(regionDataObjectType().AttrTypes),
 		Config:                   types.ObjectNull(configObjectType().AttrTypes),
+		Timeouts:                 optimeout.Null(),
 	}
 
 	up.PostValueKV = types.MapNull(types.StringType)
@@ -1003,6 +1019,7 @@ func upgradeMonitorFromV3(ctx context.Context, prior monitorV3Model) (monitorRes
 		HTTPUsername:             prior.HTTPUsername,
 		HTTPPassword:             prior.HTTPPassword,
 		CustomHTTPHeaders:        prior.CustomHTTPHeaders,
+		CustomHTTPHeadersWO:      types.MapNull(types.StringType),
 		HTTPMethodType:           prior.HTTPMethodType,
 		SuccessHTTPResponseCodes: codesSet,
 		Timeout:                  prior.Timeout,
@@ -1023,12 +1040,16 @@ func upgradeMonitorFromV3(ctx context.Context, prior monitorV3Model) (monitorRes
 		Status:                prior.Status,
 		URL:                   prior.URL,
 		Tags:                  prior.Tags,
+		TagsAll:               types.SetNull(types.StringType),
+		CustomFieldsAll:       types.MapNull(types.StringType),
+		DefaultsApplied:       types.SetNull(types.StringType),
 		AssignedAlertContacts: acSet,
 		ResponseTimeThreshold: prior.ResponseTimeThreshold,
 		RegionalData:          prior.RegionalData,
 		RegionData:            types.ObjectNull(regionDataObjectType().AttrTypes),
 		CheckSSLErrors:        prior.CheckSSLErrors,
 		Config:                config,
+		Timeouts:              optimeout.Null(),
 	}
 
 	return up, diags
@@ -1337,6 +1358,7 @@ func upgradeMonitorFromV4(ctx context.Context, prior monitorV4Model) (monitorRes
 		HTTPUsername:             prior.HTTPUsername,
 		HTTPPassword:             prior.HTTPPassword,
 		CustomHTTPHeaders:        prior.CustomHTTPHeaders,
+		CustomHTTPHeadersWO:      types.MapNull(types.StringType),
 		HTTPMethodType:           prior.HTTPMethodType,
 
 		// status codes List to Set
@@ -1357,6 +1379,9 @@ func upgradeMonitorFromV4(ctx context.Context, prior monitorV4Model) (monitorRes
 		Status:               prior.Status,
 		URL:                  prior.URL,
 		Tags:                 prior.Tags,
+		TagsAll:              types.SetNull(types.StringType),
+		CustomFieldsAll:      types.MapNull(types.StringType),
+		DefaultsApplied:      types.SetNull(types.StringType),
 
 		// Alert contacts with required defaults
 		AssignedAlertContacts: acSet,
@@ -1366,6 +1391,7 @@ func upgradeMonitorFromV4(ctx context.Context, prior monitorV4Model) (monitorRes
 		RegionData:            types.ObjectNull(regionDataObjectType().AttrTypes),
 		CheckSSLErrors:        prior.CheckSSLErrors,
 		Config:                config,
+		Timeouts:              optimeout.Null(),
 	}
 
 	return up, diags
@@ -748,23 +748,33 @@ func validateHeadersCasingDuplication(
 	data *monitorResourceModel,
 	resp *resource.ValidateConfigResponse,
 ) {
-	if !data.CustomHTTPHeaders.IsNull() && !data.CustomHTTPHeaders.IsUnknown() {
-		headersFromPlan, d := mapFromAttr(ctx, data.CustomHTTPHeaders)
+	for _, attr := range []struct {
+		name    string
+		headers types.Map
+	}{
+		{name: "custom_http_headers", headers: data.CustomHTTPHeaders},
+		{name: "custom_http_headers_wo", headers: data.CustomHTTPHeadersWO},
+	} {
+		if attr.headers.IsNull() || attr.headers.IsUnknown() {
+			continue
+		}
+		headersFromPlan, d := mapFromAttr(ctx, attr.headers)
 		resp.Diagnostics.Append(d...)
-		if !resp.Diagnostics.HasError() {
-			seen := map[string]string{}
-			for k := range headersFromPlan {
-				kl := strings.ToLower(strings.TrimSpace(k))
-				if prev, ok := seen[kl]; ok && prev != k {
-					resp.Diagnostics.AddAttributeError(
-						path.Root("custom_http_headers"),
-						"Duplicate header name (case-insensitive)",
-						fmt.Sprintf("Headers %q and %q conflict. Use a single canonical casing.", prev, k),
-					)
-					break
-				}
-				seen[kl] = k
+		if resp.Diagnostics.HasError() {
+			continue
+		}
+		seen := map[string]string{}
+		for k := range headersFromPlan {
+			kl := strings.ToLower(strings.TrimSpace(k))
+			if prev, ok := seen[kl]; ok && prev != k {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attr.name),
+					"Duplicate header name (case-insensitive)",
+					fmt.Sprintf("Headers %q and %q conflict. Use a single canonical casing.", prev, k),
+				)
+				break
 			}
+			seen[kl] = k
 		}
 	}
 }
@@ -860,12 +870,41 @@ func validateHTTPPasswordWithoutUserName(
 	data *monitorResourceModel,
 	resp *resource.ValidateConfigResponse,
 ) {
-	if !data.HTTPPassword.IsNull() && !data.HTTPPassword.IsUnknown() &&
-		(data.HTTPUsername.IsNull() || data.HTTPUsername.IsUnknown()) {
+	passwordSet := (!data.HTTPPassword.IsNull() && !data.HTTPPassword.IsUnknown()) ||
+		(!data.HTTPPasswordWO.IsNull() && !data.HTTPPasswordWO.IsUnknown())
+	if passwordSet && (data.HTTPUsername.IsNull() || data.HTTPUsername.IsUnknown()) {
 		resp.Diagnostics.AddAttributeWarning(
 			path.Root("http_username"),
 			"Password set without username",
-			"Set http_username when http_password is provided.",
+			"Set http_username when http_password or http_password_wo is provided.",
 		)
 	}
 }
+
+// monitorTypeConversions is the compatibility matrix for in-place type changes:
+// for each type, the types the API converts it to while keeping the monitor's
+// ID, history and incident log. The HTTP-like types share their URL and request
+// settings, and PING and PORT both check a bare host. Every other change
+// (including anything to or from HEARTBEAT, DNS and UDP) replaces the monitor.
+var monitorTypeConversions = map[string][]string{
+	MonitorTypeHTTP:    {MonitorTypeKEYWORD, MonitorTypeAPI},
+	MonitorTypeKEYWORD: {MonitorTypeHTTP, MonitorTypeAPI},
+	MonitorTypeAPI:     {MonitorTypeHTTP, MonitorTypeKEYWORD},
+	MonitorTypePING:    {MonitorTypePORT},
+	MonitorTypePORT:    {MonitorTypePING},
+}
+
+// monitorTypeConvertible reports whether a monitor can change from one type to
+// another with an update instead of a replacement.
+func monitorTypeConvertible(from, to string) bool {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return true
+	}
+	for _, t := range monitorTypeConversions[from] {
+		if t == to {
+			return true
+		}
+	}
+	return false
+}
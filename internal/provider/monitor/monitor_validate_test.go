@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -985,3 +987,59 @@ func TestValidateConfigIPVersion_RejectsIPv4OnlyWithIPv6LiteralOnPORT(t *testing
 		t.Fatalf("expected an error for ipv4Only with IPv6 literal on PORT, got: %v", resp.Diagnostics)
 	}
 }
+
+func TestMonitorTypeConvertible_EveryPair(t *testing.T) {
+	t.Parallel()
+
+	allTypes := []string{
+		MonitorTypeHTTP,
+		MonitorTypeKEYWORD,
+		MonitorTypePING,
+		MonitorTypePORT,
+		MonitorTypeHEARTBEAT,
+		MonitorTypeDNS,
+		MonitorTypeAPI,
+		MonitorTypeUDP,
+	}
+	inPlace := map[[2]string]bool{
+		{MonitorTypeHTTP, MonitorTypeKEYWORD}: true,
+		{MonitorTypeHTTP, MonitorTypeAPI}:     true,
+		{MonitorTypeKEYWORD, MonitorTypeHTTP}: true,
+		{MonitorTypeKEYWORD, MonitorTypeAPI}:  true,
+		{MonitorTypeAPI, MonitorTypeHTTP}:     true,
+		{MonitorTypeAPI, MonitorTypeKEYWORD}:  true,
+		{MonitorTypePING, MonitorTypePORT}:    true,
+		{MonitorTypePORT, MonitorTypePING}:    true,
+	}
+
+	for _, from := range allTypes {
+		for _, to := range allTypes {
+			from, to := from, to
+			want := from == to || inPlace[[2]string{from, to}]
+			t.Run(from+"_to_"+to, func(t *testing.T) {
+				t.Parallel()
+
+				if got := monitorTypeConvertible(from, to); got != want {
+					t.Fatalf("monitorTypeConvertible(%s, %s) = %t, want %t", from, to, got, want)
+				}
+
+				resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+				monitorTypeRequiresReplace(context.Background(), planmodifier.StringRequest{
+					StateValue: types.StringValue(from),
+					PlanValue:  types.StringValue(to),
+				}, resp)
+				if resp.RequiresReplace == want {
+					t.Fatalf("expected RequiresReplace=%t for %s -> %s", !want, from, to)
+				}
+			})
+		}
+	}
+}
+
+func TestMonitorTypeConvertible_IgnoresCase(t *testing.T) {
+	t.Parallel()
+
+	if !monitorTypeConvertible("http", "Keyword") {
+		t.Fatal("expected type comparison to ignore case")
+	}
+}
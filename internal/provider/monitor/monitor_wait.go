@@ -16,23 +16,9 @@ func (r *monitorResource) waitMonitorSettled(
 	want monComparable,
 	timeout time.Duration,
 ) (*client.Monitor, error) {
-	if timeout <= 0 {
-		timeout = 30 * time.Second
-	}
-
-	// the earliest of timeout or ctx.Deadline will be chosen to not wait more then needed
-	if dl, ok := ctx.Deadline(); ok {
-		if rem := time.Until(dl); rem > 0 && rem < timeout {
-			timeout = rem
-		}
-	}
-
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+	ctx, cancel := context.WithTimeout(ctx, monitorWaitTimeout(ctx, timeout))
 	defer cancel()
 
-	var last *client.Monitor
-	backoff := 500 * time.Millisecond
-	const maxBackoff = 3 * time.Second
 	requiredConsecutiveMatches := 3
 	if want.Timeout != nil ||
 		want.GracePeriod != nil ||
@@ -50,16 +36,62 @@ func (r *monitorResource) waitMonitorSettled(
 		// DNS records, API assertions, and headers can lag longer across API replicas.
 		requiredConsecutiveMatches = 7
 	}
+
+	last, err := pollMonitor(ctx, r.client, id, requiredConsecutiveMatches, func(m *client.Monitor) bool {
+		return equalComparable(want, buildComparableFromAPI(m))
+	})
+	if err == nil {
+		return last, nil
+	}
+	var got monComparable
+	if last != nil {
+		got = buildComparableFromAPI(last)
+	}
+	diff := fieldsStillDifferent(want, got)
+	if len(diff) > 0 {
+		return last, fmt.Errorf("timeout waiting for monitor to settle; last differences: %v: %w", diff, err)
+	}
+	return last, fmt.Errorf("timeout waiting for monitor to settle: %w", err)
+}
+
+// monitorWaitTimeout returns timeout, defaulting to 30 seconds, or the time
+// left before the ctx deadline when that is sooner, so we do not wait more
+// than needed.
+func monitorWaitTimeout(ctx context.Context, timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	if dl, ok := ctx.Deadline(); ok {
+		if rem := time.Until(dl); rem > 0 && rem < timeout {
+			timeout = rem
+		}
+	}
+	return timeout
+}
+
+// pollMonitor reads the monitor with backoff until match holds for required
+// consecutive reads, and returns that read. When ctx ends first it returns the
+// last successful read and ctx.Err(), unless that read matches: a transient
+// read error breaking the run of matches is not worth failing for.
+func pollMonitor(
+	ctx context.Context,
+	c *client.Client,
+	id int64,
+	required int,
+	match func(*client.Monitor) bool,
+) (*client.Monitor, error) {
+	var last *client.Monitor
+	backoff := 500 * time.Millisecond
+	const maxBackoff = 3 * time.Second
 	consecutiveMatches := 0
 
 	for attempt := 0; ; attempt++ {
-		m, err := r.client.GetMonitor(ctx, id)
+		m, err := c.GetMonitor(ctx, id)
 		if err == nil {
 			last = m
-			got := buildComparableFromAPI(m)
-			if equalComparable(want, got) {
+			if match(m) {
 				consecutiveMatches++
-				if consecutiveMatches >= requiredConsecutiveMatches {
+				if consecutiveMatches >= required {
 					return m, nil
 				}
 			} else {
@@ -75,21 +107,10 @@ func (r *monitorResource) waitMonitorSettled(
 		}
 		select {
 		case <-ctx.Done():
-			var got monComparable
-			if last != nil {
-				got = buildComparableFromAPI(last)
-				// If the latest read already matches what we wanted, treat settle as success.
-				// This avoids false failures when the consecutive-match window is interrupted by transient read errors.
-				if equalComparable(want, got) {
-					return last, nil
-				}
+			if last != nil && match(last) {
+				return last, nil
 			}
-			diff := fieldsStillDifferent(want, got)
-			if len(diff) > 0 {
-				return last, fmt.Errorf("timeout waiting for monitor to settle; last differences: %v: %w", diff, ctx.Err())
-			}
-			return last, fmt.Errorf("timeout waiting for monitor to settle: %w", ctx.Err())
-
+			return last, ctx.Err()
 		case <-time.After(wait):
 		}
 
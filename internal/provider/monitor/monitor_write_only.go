@@ -0,0 +1,61 @@
+package monitor
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// monitorWriteOnly holds the write-only secrets from configuration. Terraform
+// never stores them in plan or state, so they are read from configuration and
+// substituted into a copy of the plan that is only used to build API requests.
+type monitorWriteOnly struct {
+	HTTPPassword      types.String
+	CustomHTTPHeaders types.Map
+}
+
+func monitorWriteOnlyFromConfig(ctx context.Context, config tfsdk.Config) (monitorWriteOnly, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	wo := monitorWriteOnly{
+		HTTPPassword:      types.StringNull(),
+		CustomHTTPHeaders: types.MapNull(types.StringType),
+	}
+	diags.Append(config.GetAttribute(ctx, path.Root("http_password_wo"), &wo.HTTPPassword)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("custom_http_headers_wo"), &wo.CustomHTTPHeaders)...)
+	return wo, diags
+}
+
+// requestPlanForCreate returns plan with the configured write-only values in
+// place of http_password and custom_http_headers.
+func (wo monitorWriteOnly) requestPlanForCreate(plan monitorResourceModel) monitorResourceModel {
+	if !wo.HTTPPassword.IsNull() {
+		plan.HTTPPassword = wo.HTTPPassword
+	}
+	if !wo.CustomHTTPHeaders.IsNull() {
+		plan.CustomHTTPHeaders = wo.CustomHTTPHeaders
+	}
+	return plan
+}
+
+// requestPlanForUpdate is requestPlanForCreate for updates. A write-only value
+// is only sent when its *_wo_version changed, since that is the only signal
+// that it differs from what the API already has. Removing
+// custom_http_headers_wo together with its version clears the headers.
+func (wo monitorWriteOnly) requestPlanForUpdate(plan, state monitorResourceModel) monitorResourceModel {
+	if !plan.HTTPPasswordWOVersion.Equal(state.HTTPPasswordWOVersion) && !wo.HTTPPassword.IsNull() {
+		plan.HTTPPassword = wo.HTTPPassword
+	}
+	if !plan.CustomHTTPHeadersWOVersion.Equal(state.CustomHTTPHeadersWOVersion) {
+		switch {
+		case !wo.CustomHTTPHeaders.IsNull():
+			plan.CustomHTTPHeaders = wo.CustomHTTPHeaders
+		case !state.CustomHTTPHeadersWOVersion.IsNull() && plan.CustomHTTPHeaders.IsNull():
+			plan.CustomHTTPHeaders = types.MapValueMust(types.StringType, map[string]attr.Value{})
+		}
+	}
+	return plan
+}
@@ -0,0 +1,179 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+func testWriteOnlyHeaders(t *testing.T) types.Map {
+	t.Helper()
+
+	return types.MapValueMust(types.StringType, map[string]attr.Value{
+		"authorization": types.StringValue("Bearer secret"),
+	})
+}
+
+func TestMonitorWriteOnlyRequestPlanForCreate(t *testing.T) {
+	t.Parallel()
+
+	wo := monitorWriteOnly{
+		HTTPPassword:      types.StringValue("secret"),
+		CustomHTTPHeaders: testWriteOnlyHeaders(t),
+	}
+	plan := monitorResourceModel{
+		HTTPPassword:      types.StringNull(),
+		CustomHTTPHeaders: types.MapNull(types.StringType),
+	}
+
+	got := wo.requestPlanForCreate(plan)
+	if got.HTTPPassword.ValueString() != "secret" {
+		t.Fatalf("expected write-only password in request plan, got %s", got.HTTPPassword)
+	}
+	if !got.CustomHTTPHeaders.Equal(testWriteOnlyHeaders(t)) {
+		t.Fatalf("expected write-only headers in request plan, got %s", got.CustomHTTPHeaders)
+	}
+	if !plan.HTTPPassword.IsNull() || !plan.CustomHTTPHeaders.IsNull() {
+		t.Fatal("expected the original plan to stay untouched")
+	}
+
+	got = monitorWriteOnly{
+		HTTPPassword:      types.StringNull(),
+		CustomHTTPHeaders: types.MapNull(types.StringType),
+	}.requestPlanForCreate(monitorResourceModel{HTTPPassword: types.StringValue("plain")})
+	if got.HTTPPassword.ValueString() != "plain" {
+		t.Fatalf("expected http_password to be kept without a write-only value, got %s", got.HTTPPassword)
+	}
+}
+
+func TestMonitorWriteOnlyRequestPlanForUpdate(t *testing.T) {
+	t.Parallel()
+
+	wo := monitorWriteOnly{
+		HTTPPassword:      types.StringValue("secret"),
+		CustomHTTPHeaders: testWriteOnlyHeaders(t),
+	}
+	noWriteOnly := monitorWriteOnly{
+		HTTPPassword:      types.StringNull(),
+		CustomHTTPHeaders: types.MapNull(types.StringType),
+	}
+	model := func(passwordVersion, headersVersion types.Int64) monitorResourceModel {
+		return monitorResourceModel{
+			HTTPPassword:               types.StringNull(),
+			CustomHTTPHeaders:          types.MapNull(types.StringType),
+			HTTPPasswordWOVersion:      passwordVersion,
+			CustomHTTPHeadersWOVersion: headersVersion,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		wo           monitorWriteOnly
+		plan         monitorResourceModel
+		state        monitorResourceModel
+		wantPassword types.String
+		wantHeaders  types.Map
+	}{
+		{
+			name:         "unchanged versions send nothing",
+			wo:           wo,
+			plan:         model(types.Int64Value(1), types.Int64Value(1)),
+			state:        model(types.Int64Value(1), types.Int64Value(1)),
+			wantPassword: types.StringNull(),
+			wantHeaders:  types.MapNull(types.StringType),
+		},
+		{
+			name:         "changed versions send the write-only values",
+			wo:           wo,
+			plan:         model(types.Int64Value(2), types.Int64Value(2)),
+			state:        model(types.Int64Value(1), types.Int64Value(1)),
+			wantPassword: types.StringValue("secret"),
+			wantHeaders:  testWriteOnlyHeaders(t),
+		},
+		{
+			name:         "newly versioned values are sent",
+			wo:           wo,
+			plan:         model(types.Int64Value(1), types.Int64Value(1)),
+			state:        model(types.Int64Null(), types.Int64Null()),
+			wantPassword: types.StringValue("secret"),
+			wantHeaders:  testWriteOnlyHeaders(t),
+		},
+		{
+			name:         "removing write-only headers clears them",
+			wo:           noWriteOnly,
+			plan:         model(types.Int64Null(), types.Int64Null()),
+			state:        model(types.Int64Value(1), types.Int64Value(1)),
+			wantPassword: types.StringNull(),
+			wantHeaders:  types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.wo.requestPlanForUpdate(tt.plan, tt.state)
+			if !got.HTTPPassword.Equal(tt.wantPassword) {
+				t.Fatalf("expected password %s, got %s", tt.wantPassword, got.HTTPPassword)
+			}
+			if !got.CustomHTTPHeaders.Equal(tt.wantHeaders) {
+				t.Fatalf("expected headers %s, got %s", tt.wantHeaders, got.CustomHTTPHeaders)
+			}
+		})
+	}
+}
+
+func TestSetHeadersOnUpdate_WriteOnlyClearSendsEmptyHeaders(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	state := monitorResourceModel{
+		CustomHTTPHeaders:          types.MapNull(types.StringType),
+		CustomHTTPHeadersWOVersion: types.Int64Value(1),
+	}
+	plan := monitorWriteOnly{
+		HTTPPassword:      types.StringNull(),
+		CustomHTTPHeaders: types.MapNull(types.StringType),
+	}.requestPlanForUpdate(monitorResourceModel{
+		CustomHTTPHeaders:          types.MapNull(types.StringType),
+		CustomHTTPHeadersWOVersion: types.Int64Null(),
+	}, state)
+
+	req := &client.UpdateMonitorRequest{}
+	resp := &resource.UpdateResponse{}
+	setHeadersOnUpdate(ctx, plan, state, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if req.CustomHTTPHeaders == nil || len(*req.CustomHTTPHeaders) != 0 {
+		t.Fatalf("expected an empty header map to clear remote headers, got %v", req.CustomHTTPHeaders)
+	}
+}
+
+func TestReadApplyTagsHeadersAC_WriteOnlyHeadersStayOutOfState(t *testing.T) {
+	t.Parallel()
+
+	state := monitorResourceModel{
+		Name:                       types.StringValue("api"),
+		CustomHTTPHeaders:          types.MapNull(types.StringType),
+		CustomHTTPHeadersWOVersion: types.Int64Value(1),
+		AssignedAlertContacts:      types.SetNull(alertContactObjectType()),
+	}
+	resp := &resource.ReadResponse{}
+
+	readApplyTagsHeadersAC(context.Background(), resp, &state, &client.Monitor{
+		CustomHTTPHeaders: map[string]string{"authorization": "Bearer secret"},
+	}, false)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !state.CustomHTTPHeaders.IsNull() || !state.CustomHTTPHeadersWO.IsNull() {
+		t.Fatalf("expected echoed write-only headers to stay out of state, got %s and %s", state.CustomHTTPHeaders, state.CustomHTTPHeadersWO)
+	}
+}
@@ -0,0 +1,383 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+var (
+	_ datasource.DataSource                     = &monitorStatusDataSource{}
+	_ datasource.DataSourceWithConfigure        = &monitorStatusDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &monitorStatusDataSource{}
+)
+
+// monitorStatusDefaultTimeout is how long wait_for waits when no timeout is
+// configured.
+const monitorStatusDefaultTimeout = 5 * time.Minute
+
+// monitorStatusConsecutiveMatches is how many reads in a row must show the
+// wanted status. Two rides out a replica still serving the previous status.
+const monitorStatusConsecutiveMatches = 2
+
+// NewStatusDataSource returns the monitor status gate data source.
+func NewStatusDataSource() datasource.DataSource {
+	return &monitorStatusDataSource{}
+}
+
+type monitorStatusDataSource struct {
+	client *client.Client
+}
+
+type monitorStatusDataSourceModel struct {
+	MonitorIDs types.Set    `tfsdk:"monitor_ids"`
+	Tags       types.Set    `tfsdk:"tags"`
+	WaitFor    types.String `tfsdk:"wait_for"`
+	Timeout    types.String `tfsdk:"timeout"`
+	Ready      types.Bool   `tfsdk:"ready"`
+	Monitors   types.List   `tfsdk:"monitors"`
+}
+
+type monitorStatusTF struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Status               types.String `tfsdk:"status"`
+	CurrentStateDuration types.Int64  `tfsdk:"current_state_duration"`
+	LastIncident         types.Object `tfsdk:"last_incident"`
+}
+
+func (d *monitorStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = providerclient.FromDataSourceConfigure(req, resp)
+}
+
+func (d *monitorStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_status"
+}
+
+func (d *monitorStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the current status of a set of monitors, optionally waiting until they all reach a status. " +
+			"Use it after a deploy in a `check` block or a `postcondition` on `ready`.",
+		Attributes: map[string]schema.Attribute{
+			"monitor_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the monitors to read.",
+			},
+			"tags": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tag filter. Monitors carrying every configured tag are read as well.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+			"wait_for": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Status to wait for: `UP`, `DOWN` or `PAUSED`. The data source polls with backoff until " +
+					"every monitor shows it or `timeout` passes. When unset, the monitors are read once.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("UP", "DOWN", "PAUSED"),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "How long to wait for `wait_for`, as a Go duration such as `5m`. Defaults to `5m`. " +
+					"Running out of time is not an error: `ready` is false and the last statuses are returned.",
+			},
+			"ready": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether every monitor shows `wait_for`, or `UP` when `wait_for` is not set.",
+			},
+			"monitors": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of each monitor, sorted by numeric monitor ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The monitor ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The monitor name.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The monitor status returned by the API.",
+						},
+						"current_state_duration": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Seconds the monitor has been in its current status.",
+						},
+						"last_incident": schema.SingleNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The monitor's last incident, or null when it has none.",
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The incident ID.",
+								},
+								"reason": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The reason reported for the incident.",
+								},
+								"started_at": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "When the incident started, as returned by the API.",
+								},
+								"duration": schema.Int64Attribute{
+									Computed:            true,
+									MarkdownDescription: "Incident duration in seconds, or null while it is ongoing.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *monitorStatusDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("monitor_ids"),
+			path.MatchRoot("tags"),
+		),
+	}
+}
+
+func (d *monitorStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data monitorStatusDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client == nil {
+		resp.Diagnostics.AddError("Missing API Client", "The provider was not configured with an API client.")
+		return
+	}
+
+	timeout := monitorStatusDefaultTimeout
+	if !data.Timeout.IsNull() {
+		parsed, err := time.ParseDuration(data.Timeout.ValueString())
+		if err != nil || parsed <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid Timeout",
+				fmt.Sprintf("Expected a positive duration such as \"5m\", got %q.", data.Timeout.ValueString()),
+			)
+			return
+		}
+		timeout = parsed
+	}
+
+	ids, diags := d.resolveMonitorIDs(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want := "UP"
+	if !data.WaitFor.IsNull() {
+		want = data.WaitFor.ValueString()
+	}
+	monitors, err := d.readStatuses(ctx, ids, want, !data.WaitFor.IsNull(), timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read monitor status", err.Error())
+		return
+	}
+
+	data.Ready = types.BoolValue(true)
+	var notReady []string
+	tfMonitors := make([]monitorStatusTF, 0, len(monitors))
+	for _, m := range monitors {
+		if !monitorStatusIs(m.Status, want) {
+			data.Ready = types.BoolValue(false)
+			notReady = append(notReady, fmt.Sprintf("%d (%s)", m.ID, m.Status))
+		}
+		tfMonitors = append(tfMonitors, monitorStatusState(m))
+	}
+	if !data.WaitFor.IsNull() && len(notReady) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Monitors did not reach the wanted status",
+			fmt.Sprintf("After %s these monitors are not %s: %s.", timeout, want, strings.Join(notReady, ", ")),
+		)
+	}
+
+	data.Monitors, diags = types.ListValueFrom(ctx, monitorStatusObjectType(), tfMonitors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolveMonitorIDs returns the configured monitor IDs plus the monitors
+// matching the tag filter, sorted and deduplicated.
+func (d *monitorStatusDataSource) resolveMonitorIDs(ctx context.Context, data monitorStatusDataSourceModel) ([]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var ids []int64
+	if !data.MonitorIDs.IsNull() {
+		diags.Append(data.MonitorIDs.ElementsAs(ctx, &ids, false)...)
+	}
+	if !data.Tags.IsNull() {
+		tags, err := monitorStringSet(ctx, data.Tags, "tags")
+		if err != nil {
+			diags.AddError("Invalid monitor filters", err.Error())
+			return nil, diags
+		}
+		filters := monitorFilters{Tags: normalizeTagSet(tags)}
+		monitors, err := listMonitorsForLookup(ctx, d.client, filters)
+		if err != nil {
+			diags.AddError("Unable to read monitors", fmt.Sprintf("could not list monitors: %s", err))
+			return nil, diags
+		}
+		for _, m := range filterMonitors(monitors, filters) {
+			ids = append(ids, m.ID)
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+	if len(ids) == 0 {
+		diags.AddError("No monitors found", "No monitor matches the configured monitor_ids and tags.")
+	}
+	return ids, diags
+}
+
+// readStatuses reads every monitor. With wait set it polls each one until it
+// shows want, sharing one deadline; monitors still being polled when it passes
+// are read once more without it so their current status is reported.
+func (d *monitorStatusDataSource) readStatuses(ctx context.Context, ids []int64, want string, wait bool, timeout time.Duration) ([]*client.Monitor, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	monitors := make([]*client.Monitor, 0, len(ids))
+	for _, id := range ids {
+		var m *client.Monitor
+		if wait {
+			m, _ = pollMonitor(waitCtx, d.client, id, monitorStatusConsecutiveMatches, func(m *client.Monitor) bool {
+				return monitorStatusIs(m.Status, want)
+			})
+		}
+		if m == nil || waitCtx.Err() != nil {
+			var err error
+			m, err = d.client.GetMonitor(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("could not read monitor ID %d: %w", id, err)
+			}
+		}
+		monitors = append(monitors, m)
+	}
+	return monitors, nil
+}
+
+func monitorStatusIs(status, want string) bool {
+	return strings.EqualFold(strings.TrimSpace(status), want)
+}
+
+func monitorStatusObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":                     types.StringType,
+		"name":                   types.StringType,
+		"status":                 types.StringType,
+		"current_state_duration": types.Int64Type,
+		"last_incident":          monitorIncidentObjectType(),
+	}}
+}
+
+func monitorIncidentObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":         types.StringType,
+		"reason":     types.StringType,
+		"started_at": types.StringType,
+		"duration":   types.Int64Type,
+	}}
+}
+
+func monitorStatusState(m *client.Monitor) monitorStatusTF {
+	return monitorStatusTF{
+		ID:                   types.StringValue(strconv.FormatInt(m.ID, 10)),
+		Name:                 types.StringValue(m.Name),
+		Status:               types.StringValue(m.Status),
+		CurrentStateDuration: types.Int64Value(int64(m.CurrentStateDuration)),
+		LastIncident:         monitorIncidentState(m),
+	}
+}
+
+// monitorIncidentState prefers the embedded incident and falls back to
+// lastIncidentId, which some list and read payloads carry alone.
+func monitorIncidentState(m *client.Monitor) types.Object {
+	attrTypes := monitorIncidentObjectType().AttrTypes
+	if m.LastIncident == nil {
+		if m.LastIncidentID == "" {
+			return types.ObjectNull(attrTypes)
+		}
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"id":         types.StringValue(string(m.LastIncidentID)),
+			"reason":     types.StringNull(),
+			"started_at": types.StringNull(),
+			"duration":   types.Int64Null(),
+		})
+	}
+
+	incident := m.LastIncident
+	id := incident.ID
+	if id == "" {
+		id = string(m.LastIncidentID)
+	}
+	duration := types.Int64Null()
+	if incident.Duration != nil {
+		duration = types.Int64Value(int64(*incident.Duration))
+	}
+	return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"id":         stringOrNull(id),
+		"reason":     stringOrNull(incident.Reason),
+		"started_at": stringOrNull(incidentStartedAt(incident.StartedAt)),
+		"duration":   duration,
+	})
+}
+
+// incidentStartedAt formats startedAt, which the API returns either as a
+// timestamp string or as Unix seconds.
+func incidentStartedAt(v any) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return time.Unix(int64(t), 0).UTC().Format(time.RFC3339)
+	default:
+		return ""
+	}
+}
+
+func stringOrNull(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
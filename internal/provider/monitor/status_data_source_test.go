@@ -0,0 +1,116 @@
+package monitor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+func TestMonitorStatusDataSource_ReadStatusesWaitsForStatus(t *testing.T) {
+	t.Parallel()
+
+	var reads atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Path != "/monitors/42" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// DOWN on the first read, UP afterwards.
+		if reads.Add(1) == 1 {
+			_, _ = w.Write([]byte(`{"id":42,"friendlyName":"API","status":"DOWN"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":42,"friendlyName":"API","status":"UP","currentStateDuration":12}`))
+	}))
+	defer srv.Close()
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(srv.URL)
+	d := &monitorStatusDataSource{client: apiClient}
+
+	monitors, err := d.readStatuses(context.Background(), []int64{42}, "UP", true, 30*time.Second)
+	if err != nil {
+		t.Fatalf("readStatuses: %v", err)
+	}
+	if len(monitors) != 1 || monitors[0].Status != "UP" || monitors[0].CurrentStateDuration != 12 {
+		t.Fatalf("unexpected monitors: %+v", monitors)
+	}
+	if got := reads.Load(); got < 1+monitorStatusConsecutiveMatches {
+		t.Fatalf("expected at least %d reads, got %d", 1+monitorStatusConsecutiveMatches, got)
+	}
+}
+
+func TestMonitorStatusDataSource_ReadStatusesReturnsLastStatusOnTimeout(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":42,"friendlyName":"API","status":"DOWN"}`))
+	}))
+	defer srv.Close()
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(srv.URL)
+	d := &monitorStatusDataSource{client: apiClient}
+
+	monitors, err := d.readStatuses(context.Background(), []int64{42, 43}, "UP", true, 200*time.Millisecond)
+	if err != nil {
+		t.Fatalf("readStatuses: %v", err)
+	}
+	if len(monitors) != 2 || monitors[0].Status != "DOWN" || monitors[1].Status != "DOWN" {
+		t.Fatalf("expected both monitors read as DOWN, got %+v", monitors)
+	}
+}
+
+func TestMonitorIncidentState(t *testing.T) {
+	t.Parallel()
+
+	duration := 90
+	tests := []struct {
+		name        string
+		monitor     client.Monitor
+		wantNull    bool
+		wantID      string
+		wantStarted string
+	}{
+		{name: "none", monitor: client.Monitor{}, wantNull: true},
+		{name: "id only", monitor: client.Monitor{LastIncidentID: "77"}, wantID: "77"},
+		{
+			name: "embedded",
+			monitor: client.Monitor{LastIncident: &client.Incident{
+				ID: "78", Reason: "Timeout", StartedAt: float64(1767225600), Duration: &duration,
+			}},
+			wantID:      "78",
+			wantStarted: "2026-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := monitorIncidentState(&tt.monitor)
+			if got.IsNull() != tt.wantNull {
+				t.Fatalf("expected null=%v, got %v", tt.wantNull, got)
+			}
+			if tt.wantNull {
+				return
+			}
+			attrs := got.Attributes()
+			if id := attrs["id"].(types.String).ValueString(); id != tt.wantID {
+				t.Fatalf("expected id %q, got %q", tt.wantID, id)
+			}
+			if started := attrs["started_at"].(types.String).ValueString(); started != tt.wantStarted {
+				t.Fatalf("expected started_at %q, got %q", tt.wantStarted, started)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package monitorgroup
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+// defaultMonitorGroupID is the group monitors fall back to when they are
+// removed from a managed group.
+const defaultMonitorGroupID int64 = 0
+
+// reconcileMonitorGroupMembers moves monitors so that exactly the desired IDs
+// belong to the group. Monitors in current but not in desired are moved to the
+// default group. It returns the members the group has afterwards, which on
+// error include the moves made before it.
+func reconcileMonitorGroupMembers(
+	ctx context.Context,
+	apiClient *client.Client,
+	groupID int64,
+	current []int64,
+	desired []int64,
+) ([]int64, error) {
+	add, remove := diffMonitorIDs(current, desired)
+	members := normalizeMonitorIDs(current)
+
+	for _, monitorID := range add {
+		if _, err := apiClient.AssignMonitorToGroup(ctx, monitorID, groupID); err != nil {
+			return members, fmt.Errorf("adding monitor %d to group %d: %w", monitorID, groupID, err)
+		}
+		members = normalizeMonitorIDs(append(members, monitorID))
+	}
+	for _, monitorID := range remove {
+		if _, err := apiClient.AssignMonitorToGroup(ctx, monitorID, defaultMonitorGroupID); err != nil && !client.IsNotFound(err) {
+			return members, fmt.Errorf("removing monitor %d from group %d: %w", monitorID, groupID, err)
+		}
+		members = slices.DeleteFunc(members, func(id int64) bool { return id == monitorID })
+	}
+	return members, nil
+}
+
+// diffMonitorIDs returns the sorted IDs that must be added to and removed from current to reach desired.
+func diffMonitorIDs(current, desired []int64) ([]int64, []int64) {
+	current = normalizeMonitorIDs(current)
+	desired = normalizeMonitorIDs(desired)
+
+	var add, remove []int64
+	for _, id := range desired {
+		if _, found := slices.BinarySearch(current, id); !found {
+			add = append(add, id)
+		}
+	}
+	for _, id := range current {
+		if _, found := slices.BinarySearch(desired, id); !found {
+			remove = append(remove, id)
+		}
+	}
+	return add, remove
+}
+
+func normalizeMonitorIDs(monitorIDs []int64) []int64 {
+	cp := append([]int64{}, monitorIDs...)
+	slices.Sort(cp)
+	return slices.Compact(cp)
+}
+
+func monitorIDsFromSet(ctx context.Context, value types.Set) ([]int64, diag.Diagnostics) {
+	var monitorIDs []int64
+	diags := value.ElementsAs(ctx, &monitorIDs, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return normalizeMonitorIDs(monitorIDs), diags
+}
+
+func monitorIDsSet(ctx context.Context, monitorIDs []int64) (types.Set, diag.Diagnostics) {
+	return types.SetValueFrom(ctx, types.Int64Type, normalizeMonitorIDs(monitorIDs))
+}
@@ -0,0 +1,248 @@
+package monitorgroup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &monitorGroupMembershipResource{}
+	_ resource.ResourceWithConfigure   = &monitorGroupMembershipResource{}
+	_ resource.ResourceWithImportState = &monitorGroupMembershipResource{}
+)
+
+// NewMembershipResource is a helper function to simplify the provider implementation.
+func NewMembershipResource() resource.Resource {
+	return &monitorGroupMembershipResource{}
+}
+
+// monitorGroupMembershipResource manages a single monitor's membership in a group
+// without taking ownership of the group's other members.
+type monitorGroupMembershipResource struct {
+	client *client.Client
+}
+
+type monitorGroupMembershipResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	GroupID   types.Int64    `tfsdk:"group_id"`
+	MonitorID types.Int64    `tfsdk:"monitor_id"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *monitorGroupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = providerclient.FromResourceConfigure(req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *monitorGroupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_group_membership"
+}
+
+// Schema defines the schema for the resource.
+func (r *monitorGroupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the membership of a single monitor in a UptimeRobot monitor group. " +
+			"This resource is non-authoritative: other monitors in the group are left untouched. " +
+			"Destroying it moves the monitor back to the default group if it is still in `group_id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Membership identifier in the form `<group_id>/<monitor_id>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				Description: "Monitor group ID the monitor should belong to.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"monitor_id": schema.Int64Attribute{
+				Description: "Monitor ID to place in the group.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			optimeout.BlockName: optimeout.Block(),
+		},
+	}
+}
+
+// Create assigns the monitor to the group.
+func (r *monitorGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan monitorGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := optimeout.Create(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := createTimeout.Context(ctx)
+	defer cancel()
+
+	groupID := plan.GroupID.ValueInt64()
+	monitorID := plan.MonitorID.ValueInt64()
+
+	if _, err := r.client.AssignMonitorToGroup(ctx, monitorID, groupID); err != nil {
+		apidiag.AddRequestError(&resp.Diagnostics, "Error creating monitor group membership", err.Error(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(membershipID(groupID, monitorID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the membership state. A monitor that moved to another group is
+// removed from state so the next plan re-adds it.
+func (r *monitorGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state monitorGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := optimeout.Read(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := readTimeout.Context(ctx)
+	defer cancel()
+
+	monitor, err := r.client.GetMonitor(ctx, state.MonitorID.ValueInt64())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading monitor group membership", err.Error())
+		return
+	}
+
+	if monitor.GroupID != state.GroupID.ValueInt64() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(membershipID(state.GroupID.ValueInt64(), state.MonitorID.ValueInt64()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update is never called because every attribute requires replacement.
+func (r *monitorGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan monitorGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete moves the monitor back to the default group if it still belongs to the managed group.
+func (r *monitorGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state monitorGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := optimeout.Delete(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := deleteTimeout.Context(ctx)
+	defer cancel()
+
+	if err := releaseMonitorFromGroup(ctx, r.client, state.GroupID.ValueInt64(), state.MonitorID.ValueInt64()); err != nil {
+		apidiag.AddRequestError(&resp.Diagnostics, "Error deleting monitor group membership", err.Error(), err)
+	}
+}
+
+// ImportState imports an existing membership using `<group_id>/<monitor_id>`.
+func (r *monitorGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, monitorID, err := parseMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid monitor group membership import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), membershipID(groupID, monitorID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitor_id"), monitorID)...)
+}
+
+// releaseMonitorFromGroup moves a monitor to the default group if it still belongs to groupID.
+// A monitor that was already moved elsewhere is left alone.
+func releaseMonitorFromGroup(ctx context.Context, apiClient *client.Client, groupID, monitorID int64) error {
+	monitor, err := apiClient.GetMonitor(ctx, monitorID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if monitor.GroupID != groupID {
+		return nil
+	}
+
+	if _, err := apiClient.AssignMonitorToGroup(ctx, monitorID, defaultMonitorGroupID); err != nil && !client.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func membershipID(groupID, monitorID int64) string {
+	return strconv.FormatInt(groupID, 10) + "/" + strconv.FormatInt(monitorID, 10)
+}
+
+func parseMembershipID(raw string) (int64, int64, error) {
+	parts := strings.Split(strings.TrimSpace(raw), "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected import ID in the form <group_id>/<monitor_id>, got %q", raw)
+	}
+
+	groupID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || groupID < 1 {
+		return 0, 0, fmt.Errorf("group_id must be a positive integer, got %q", parts[0])
+	}
+	monitorID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || monitorID < 1 {
+		return 0, 0, fmt.Errorf("monitor_id must be a positive integer, got %q", parts[1])
+	}
+	return groupID, monitorID, nil
+}
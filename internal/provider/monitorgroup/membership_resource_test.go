@@ -0,0 +1,107 @@
+package monitorgroup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+func TestMonitorGroupMembershipResource_Metadata(t *testing.T) {
+	t.Parallel()
+
+	r := NewMembershipResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "uptimerobot"}, resp)
+
+	if resp.TypeName != "uptimerobot_monitor_group_membership" {
+		t.Fatalf("unexpected type name %q", resp.TypeName)
+	}
+}
+
+func TestMonitorGroupMembershipResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	r := NewMembershipResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	for _, name := range []string{"id", "group_id", "monitor_id"} {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Fatalf("expected schema attribute %q", name)
+		}
+	}
+}
+
+func TestParseMembershipID(t *testing.T) {
+	t.Parallel()
+
+	groupID, monitorID, err := parseMembershipID("101/555")
+	if err != nil {
+		t.Fatalf("parseMembershipID returned error: %v", err)
+	}
+	if groupID != 101 || monitorID != 555 {
+		t.Fatalf("unexpected IDs %d/%d", groupID, monitorID)
+	}
+	if got := membershipID(groupID, monitorID); got != "101/555" {
+		t.Fatalf("unexpected round-trip ID %q", got)
+	}
+
+	for _, raw := range []string{"", "101", "101/555/1", "abc/555", "101/abc", "0/555", "101/-1"} {
+		if _, _, err := parseMembershipID(raw); err == nil {
+			t.Fatalf("expected error for %q", raw)
+		}
+	}
+}
+
+func TestReleaseMonitorFromGroup_SkipsMonitorInOtherGroup(t *testing.T) {
+	t.Parallel()
+
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		seen = append(seen, req.Method+" "+req.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":555,"friendlyName":"api","type":"HTTP","groupId":202}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(server.URL)
+
+	if err := releaseMonitorFromGroup(context.Background(), apiClient, 101, 555); err != nil {
+		t.Fatalf("releaseMonitorFromGroup returned error: %v", err)
+	}
+
+	if strings.Join(seen, "\n") != "GET /monitors/555" {
+		t.Fatalf("expected only a monitor read, got:\n%s", strings.Join(seen, "\n"))
+	}
+}
+
+func TestReleaseMonitorFromGroup_MovesMonitorToDefaultGroup(t *testing.T) {
+	t.Parallel()
+
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		seen = append(seen, req.Method+" "+req.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":555,"friendlyName":"api","type":"HTTP","groupId":101}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(server.URL)
+
+	if err := releaseMonitorFromGroup(context.Background(), apiClient, 101, 555); err != nil {
+		t.Fatalf("releaseMonitorFromGroup returned error: %v", err)
+	}
+
+	// AssignMonitorToGroup reads the monitor again to build the update.
+	want := []string{"GET /monitors/555", "GET /monitors/555", "PATCH /monitors/555"}
+	if strings.Join(seen, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected requests:\n%s", strings.Join(seen, "\n"))
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +19,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/deletionprotection"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
 )
 
@@ -33,20 +39,35 @@ func NewResource() resource.Resource {
 
 // monitorGroupResource is the resource implementation.
 type monitorGroupResource struct {
-	client *client.Client
+	client     *client.Client
+	protectAll bool
 }
 
 type monitorGroupResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	MonitorsNewGroupID types.Int64  `tfsdk:"monitors_new_group_id"`
-	CreatedAt          types.String `tfsdk:"created_at"`
-	UpdatedAt          types.String `tfsdk:"updated_at"`
+	ID                 types.String   `tfsdk:"id"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Name               types.String   `tfsdk:"name"`
+	MonitorsNewGroupID types.Int64    `tfsdk:"monitors_new_group_id"`
+	MonitorIDs         types.Set      `tfsdk:"monitor_ids"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	UpdatedAt          types.String   `tfsdk:"updated_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
+// Default waits used when the timeouts block does not override them.
+const (
+	monitorGroupSettleTimeout     = 90 * time.Second
+	monitorGroupReadSettleTimeout = 10 * time.Second
+)
+
 // Configure adds the provider configured client to the resource.
 func (r *monitorGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerclient.FromResourceConfigure(req, resp)
+	data := providerclient.DataFromResourceConfigure(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+	r.protectAll = data.ProtectAll
 }
 
 // Metadata returns the resource type name.
@@ -59,6 +80,7 @@ func (r *monitorGroupResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Manages a UptimeRobot monitor group.",
 		Attributes: map[string]schema.Attribute{
+			deletionprotection.AttributeName: deletionprotection.Attribute(),
 			"id": schema.StringAttribute{
 				Description: "Monitor group identifier",
 				Computed:    true,
@@ -80,6 +102,16 @@ func (r *monitorGroupResource) Schema(_ context.Context, _ resource.SchemaReques
 					int64validator.AtLeast(1),
 				},
 			},
+			"monitor_ids": schema.SetAttribute{
+				MarkdownDescription: "Authoritative set of monitor IDs that belong to this group. Monitors missing from the set are moved to the default group. " +
+					"If omitted, group membership is not managed by this resource. " +
+					"Do not combine with `uptimerobot_monitor_group_membership` or `uptimerobot_monitor.group_id` for the same group.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the monitor group was created.",
 				Computed:    true,
@@ -92,6 +124,9 @@ func (r *monitorGroupResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			optimeout.BlockName: optimeout.Block(),
+		},
 	}
 }
 
@@ -103,14 +138,22 @@ func (r *monitorGroupResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := optimeout.Create(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := createTimeout.Context(ctx)
+	defer cancel()
+
 	group, err := r.client.CreateMonitorGroup(ctx, &client.CreateMonitorGroupRequest{
 		Name: plan.Name.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating monitor group", err.Error())
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, nil, "Error creating monitor group", err.Error(), err)
 		return
 	}
-	settled, err := r.waitMonitorGroupName(ctx, group.ID, plan.Name.ValueString(), 90*time.Second)
+	settled, err := r.waitMonitorGroupName(ctx, group.ID, plan.Name.ValueString(), createTimeout.Wait(monitorGroupSettleTimeout))
 	if err != nil {
 		if ctx.Err() != nil {
 			resp.Diagnostics.AddError("Error waiting for monitor group stabilization", err.Error())
@@ -124,6 +167,29 @@ func (r *monitorGroupResource) Create(ctx context.Context, req resource.CreateRe
 		group = settled
 	}
 
+	if !plan.MonitorIDs.IsNull() && !plan.MonitorIDs.IsUnknown() {
+		desired, diags := monitorIDsFromSet(ctx, plan.MonitorIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		current, err := r.client.ListMonitorGroupMemberIDs(ctx, group.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading monitor group members", err.Error())
+			return
+		}
+		if members, err := reconcileMonitorGroupMembers(ctx, r.client, group.ID, current, desired); err != nil {
+			// The group exists, so keep it in state with the members moved so
+			// far and let the next apply finish membership.
+			plan.applyAPI(group)
+			plan.MonitorIDs, diags = monitorIDsSet(ctx, members)
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			apidiag.AddAttributeRequestError(&resp.Diagnostics, path.Root("monitor_ids"), "Error assigning monitors to monitor group", err.Error(), err)
+			return
+		}
+	}
+
 	plan.applyAPI(group)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -142,6 +208,14 @@ func (r *monitorGroupResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	readTimeout, diags := optimeout.Read(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := readTimeout.Context(ctx)
+	defer cancel()
+
 	group, err := r.client.GetMonitorGroup(ctx, id)
 	if err != nil {
 		if client.IsNotFound(err) {
@@ -155,7 +229,7 @@ func (r *monitorGroupResource) Read(ctx context.Context, req resource.ReadReques
 	if !state.Name.IsNull() && !state.Name.IsUnknown() {
 		expectedName := state.Name.ValueString()
 		if expectedName != "" && group.Name != expectedName {
-			settled, err := r.waitMonitorGroupName(ctx, id, expectedName, 10*time.Second)
+			settled, err := r.waitMonitorGroupName(ctx, id, expectedName, readTimeout.Wait(monitorGroupReadSettleTimeout))
 			if err != nil {
 				if ctx.Err() != nil {
 					resp.Diagnostics.AddError("Error waiting for monitor group stabilization", err.Error())
@@ -170,6 +244,17 @@ func (r *monitorGroupResource) Read(ctx context.Context, req resource.ReadReques
 		}
 	}
 
+	if !state.MonitorIDs.IsNull() {
+		members, err := r.client.ListMonitorGroupMemberIDs(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading monitor group members", err.Error())
+			return
+		}
+		var diags diag.Diagnostics
+		state.MonitorIDs, diags = monitorIDsSet(ctx, members)
+		resp.Diagnostics.Append(diags...)
+	}
+
 	state.applyAPI(group)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -190,17 +275,25 @@ func (r *monitorGroupResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := optimeout.Update(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := updateTimeout.Context(ctx)
+	defer cancel()
+
 	var group *client.MonitorGroup
 	if !plan.Name.Equal(state.Name) {
 		group, err = r.client.UpdateMonitorGroup(ctx, id, &client.UpdateMonitorGroupRequest{
 			Name: plan.Name.ValueString(),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating monitor group", err.Error())
+			apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, nil, "Error updating monitor group", err.Error(), err)
 			return
 		}
 
-		settled, err := r.waitMonitorGroupName(ctx, id, plan.Name.ValueString(), 90*time.Second)
+		settled, err := r.waitMonitorGroupName(ctx, id, plan.Name.ValueString(), updateTimeout.Wait(monitorGroupSettleTimeout))
 		if err != nil {
 			if ctx.Err() != nil {
 				resp.Diagnostics.AddError("Error waiting for monitor group stabilization", err.Error())
@@ -221,6 +314,29 @@ func (r *monitorGroupResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 	}
 
+	if !plan.MonitorIDs.IsNull() && !plan.MonitorIDs.IsUnknown() {
+		desired, diags := monitorIDsFromSet(ctx, plan.MonitorIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		current, err := r.client.ListMonitorGroupMemberIDs(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading monitor group members", err.Error())
+			return
+		}
+		if members, err := reconcileMonitorGroupMembers(ctx, r.client, id, current, desired); err != nil {
+			// Record the members moved so far, so the next plan shows what
+			// is left to do.
+			plan.applyAPI(group)
+			plan.MonitorIDs, diags = monitorIDsSet(ctx, members)
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			apidiag.AddAttributeRequestError(&resp.Diagnostics, path.Root("monitor_ids"), "Error updating monitor group members", err.Error(), err)
+			return
+		}
+	}
+
 	plan.applyAPI(group)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -238,6 +354,17 @@ func (r *monitorGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 		resp.Diagnostics.AddError("Invalid monitor group ID", err.Error())
 		return
 	}
+	if !deletionprotection.CheckDelete(state.DeletionProtection, r.protectAll, "monitor group", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	deleteTimeout, diags := optimeout.Delete(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := deleteTimeout.Context(ctx)
+	defer cancel()
 
 	var monitorsNewGroupID *int64
 	if !state.MonitorsNewGroupID.IsNull() && !state.MonitorsNewGroupID.IsUnknown() {
@@ -249,11 +376,11 @@ func (r *monitorGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 		if client.IsNotFound(err) {
 			return
 		}
-		resp.Diagnostics.AddError("Error deleting monitor group", err.Error())
+		apidiag.AddRequestError(&resp.Diagnostics, "Error deleting monitor group", err.Error(), err)
 		return
 	}
 
-	if err := r.client.WaitMonitorGroupDeleted(ctx, id, 90*time.Second); err != nil {
+	if err := r.client.WaitMonitorGroupDeleted(ctx, id, deleteTimeout.Wait(monitorGroupSettleTimeout)); err != nil {
 		resp.Diagnostics.AddError("Error waiting for monitor group deletion", err.Error())
 	}
 }
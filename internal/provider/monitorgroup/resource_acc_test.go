@@ -279,3 +279,118 @@ func TestAccMonitorGroup_WithMonitorAssignment(t *testing.T) {
 		},
 	})
 }
+
+func testAccMonitorGroupAuthoritativeMembersConfig(groupName, monitorName string, includeMonitor bool) string {
+	url := provideracctest.UniqueURL(monitorName)
+	monitorIDs := "[]"
+	if includeMonitor {
+		monitorIDs = "[tonumber(uptimerobot_monitor.test.id)]"
+	}
+	return provideracctest.ProviderConfig() + fmt.Sprintf(`
+resource "uptimerobot_monitor" "test" {
+  name     = %q
+  url      = %q
+  type     = "HTTP"
+  interval = 300
+  timeout  = 30
+}
+
+resource "uptimerobot_monitor_group" "test" {
+  name        = %q
+  monitor_ids = %s
+}
+`, monitorName, url, groupName, monitorIDs)
+}
+
+func TestAccMonitorGroup_AuthoritativeMonitorIDs(t *testing.T) {
+	groupName := acctest.RandomWithPrefix("acc-monitor-group-members")
+	monitorName := acctest.RandomWithPrefix("acc-monitor-group-member")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provideracctest.PreCheck(t) },
+		ProtoV6ProviderFactories: provideracctest.ProtoV6ProviderFactories,
+		CheckDestroy:             provideracctest.CheckMonitorGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorGroupAuthoritativeMembersConfig(groupName, monitorName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor_group.test", "monitor_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"uptimerobot_monitor_group.test",
+						"monitor_ids.*",
+						"uptimerobot_monitor.test",
+						"id",
+					),
+				),
+			},
+			{
+				Config:             testAccMonitorGroupAuthoritativeMembersConfig(groupName, monitorName, true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: testAccMonitorGroupAuthoritativeMembersConfig(groupName, monitorName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_monitor_group.test", "monitor_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMonitorGroupMembershipConfig(groupName, monitorName string) string {
+	url := provideracctest.UniqueURL(monitorName)
+	return provideracctest.ProviderConfig() + fmt.Sprintf(`
+resource "uptimerobot_monitor_group" "test" {
+  name = %q
+}
+
+resource "uptimerobot_monitor" "test" {
+  name     = %q
+  url      = %q
+  type     = "HTTP"
+  interval = 300
+  timeout  = 30
+}
+
+resource "uptimerobot_monitor_group_membership" "test" {
+  group_id   = tonumber(uptimerobot_monitor_group.test.id)
+  monitor_id = tonumber(uptimerobot_monitor.test.id)
+}
+`, groupName, monitorName, url)
+}
+
+func TestAccMonitorGroupMembership_Basic(t *testing.T) {
+	groupName := acctest.RandomWithPrefix("acc-monitor-group-membership")
+	monitorName := acctest.RandomWithPrefix("acc-monitor-group-membership")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provideracctest.PreCheck(t) },
+		ProtoV6ProviderFactories: provideracctest.ProtoV6ProviderFactories,
+		CheckDestroy:             provideracctest.CheckMonitorGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorGroupMembershipConfig(groupName, monitorName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"uptimerobot_monitor_group_membership.test",
+						"group_id",
+						"uptimerobot_monitor_group.test",
+						"id",
+					),
+					resource.TestCheckResourceAttrSet("uptimerobot_monitor_group_membership.test", "id"),
+				),
+			},
+			{
+				Config:             testAccMonitorGroupMembershipConfig(groupName, monitorName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      "uptimerobot_monitor_group_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
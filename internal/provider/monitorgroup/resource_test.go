@@ -2,9 +2,12 @@ package monitorgroup
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -32,7 +35,7 @@ func TestMonitorGroupResource_Schema(t *testing.T) {
 	resp := &resource.SchemaResponse{}
 	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
 
-	for _, name := range []string{"id", "name", "monitors_new_group_id", "created_at", "updated_at"} {
+	for _, name := range []string{"id", "name", "monitors_new_group_id", "monitor_ids", "created_at", "updated_at"} {
 		if _, ok := resp.Schema.Attributes[name]; !ok {
 			t.Fatalf("expected schema attribute %q", name)
 		}
@@ -119,3 +122,94 @@ func TestMonitorGroupResourceWaitNameReturnsLastOnTimeout(t *testing.T) {
 		t.Fatalf("expected last observed name old, got %q", group.Name)
 	}
 }
+
+func TestDiffMonitorIDs(t *testing.T) {
+	t.Parallel()
+
+	add, remove := diffMonitorIDs([]int64{5, 1, 3, 3}, []int64{3, 7, 1, 9})
+	if fmt.Sprint(add) != "[7 9]" {
+		t.Fatalf("unexpected add %v", add)
+	}
+	if fmt.Sprint(remove) != "[5]" {
+		t.Fatalf("unexpected remove %v", remove)
+	}
+
+	add, remove = diffMonitorIDs(nil, nil)
+	if len(add) != 0 || len(remove) != 0 {
+		t.Fatalf("expected no changes, got add=%v remove=%v", add, remove)
+	}
+}
+
+func TestReconcileMonitorGroupMembers(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Path == "/monitors/8" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"not found"}`))
+			return
+		}
+		if req.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"id":1,"friendlyName":"a &amp; b","type":"HTTP","interval":300,"url":"https://example.com","followRedirections":true}`))
+			return
+		}
+		body, _ := io.ReadAll(req.Body)
+		mu.Lock()
+		seen = append(seen, req.Method+" "+req.URL.RequestURI()+" "+string(body))
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(server.URL)
+
+	members, err := reconcileMonitorGroupMembers(context.Background(), apiClient, 101, []int64{1, 2, 8}, []int64{1, 3})
+	if err != nil {
+		t.Fatalf("reconcile returned error: %v", err)
+	}
+	if fmt.Sprint(members) != "[1 3]" {
+		t.Fatalf("unexpected members %v", members)
+	}
+
+	// Each move is a full update built from the monitor, so the PATCH does
+	// not reset its name, URL, interval or flags.
+	const monitor = `"friendlyName":"a \u0026 b","url":"https://example.com","type":"HTTP","interval":300,"sslExpirationReminder":false,"domainExpirationReminder":false,"followRedirections":true`
+	want := []string{
+		`PATCH /monitors/3 {` + monitor + `,"groupId":101}`,
+		`PATCH /monitors/2 {` + monitor + `,"groupId":0}`,
+	}
+	if strings.Join(seen, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected requests:\n%s", strings.Join(seen, "\n"))
+	}
+}
+
+func TestReconcileMonitorGroupMembersReturnsPartialMembers(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if req.Method == http.MethodPatch && req.URL.Path == "/monitors/2" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"bad request"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":1,"friendlyName":"m","type":"HTTP","interval":300,"url":"https://example.com"}`))
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(server.URL)
+
+	members, err := reconcileMonitorGroupMembers(context.Background(), apiClient, 101, []int64{1, 2}, []int64{1, 3})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	// Monitor 3 was moved in before removing monitor 2 failed.
+	if fmt.Sprint(members) != "[1 2 3]" {
+		t.Fatalf("unexpected members %v", members)
+	}
+}
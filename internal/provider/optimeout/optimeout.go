@@ -0,0 +1,98 @@
+// Package optimeout implements the timeouts block shared by every resource.
+//
+// Resources wait for the API to settle after most writes. Each of those waits
+// has its own default; a configured timeout replaces every default within the
+// operation and also bounds the operation as a whole.
+package optimeout
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BlockName is the name of the timeouts block in every resource schema.
+const BlockName = "timeouts"
+
+// Block returns the timeouts block with create, read, update and delete. It is
+// also part of prior schema versions, which do not have a context at hand; the
+// context timeouts.Block accepts is unused.
+func Block() schema.Block {
+	return timeouts.Block(context.Background(), timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: "How long to wait for the object to be created and settle, e.g. `30m`. Defaults to the provider's built-in waits.",
+		ReadDescription:   "How long to wait while reading the object, e.g. `5m`. Defaults to the provider's built-in waits.",
+		UpdateDescription: "How long to wait for an update to settle, e.g. `30m`. Defaults to the provider's built-in waits.",
+		DeleteDescription: "How long to wait for the object to disappear after deletion, e.g. `10m`. Defaults to the provider's built-in waits.",
+	})
+}
+
+// Null returns an unset timeouts value for state that is not built from a plan,
+// such as upgraded state.
+func Null() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
+// Operation is the configured timeout of a single resource operation. The zero
+// value means no timeout was configured.
+type Operation struct {
+	timeout time.Duration
+}
+
+// Create returns the configured create timeout.
+func Create(ctx context.Context, v timeouts.Value) (Operation, diag.Diagnostics) {
+	d, diags := v.Create(ctx, 0)
+	return Operation{timeout: d}, diags
+}
+
+// Read returns the configured read timeout.
+func Read(ctx context.Context, v timeouts.Value) (Operation, diag.Diagnostics) {
+	d, diags := v.Read(ctx, 0)
+	return Operation{timeout: d}, diags
+}
+
+// Update returns the configured update timeout.
+func Update(ctx context.Context, v timeouts.Value) (Operation, diag.Diagnostics) {
+	d, diags := v.Update(ctx, 0)
+	return Operation{timeout: d}, diags
+}
+
+// Delete returns the configured delete timeout.
+func Delete(ctx context.Context, v timeouts.Value) (Operation, diag.Diagnostics) {
+	d, diags := v.Delete(ctx, 0)
+	return Operation{timeout: d}, diags
+}
+
+// Context bounds ctx by the configured timeout. Without one, ctx is returned
+// unchanged so every wait keeps its own default.
+func (o Operation) Context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if o.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, o.timeout)
+}
+
+// Wait returns how long a single wait within the operation may take: the
+// configured timeout, or def when none is configured. Waits are additionally
+// capped by the deadline of the context returned by Context.
+func (o Operation) Wait(def time.Duration) time.Duration {
+	if o.timeout <= 0 {
+		return def
+	}
+	return o.timeout
+}
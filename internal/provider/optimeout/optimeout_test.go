@@ -0,0 +1,84 @@
+package optimeout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOperationUnset(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	op, diags := Create(ctx, Null())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := op.Wait(90 * time.Second); got != 90*time.Second {
+		t.Fatalf("expected the default wait, got %s", got)
+	}
+
+	bounded, cancel := op.Context(ctx)
+	defer cancel()
+	if _, ok := bounded.Deadline(); ok {
+		t.Fatal("expected no deadline without a configured timeout")
+	}
+}
+
+func TestOperationConfigured(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	v := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create": types.StringType,
+				"read":   types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			},
+			map[string]attr.Value{
+				"create": types.StringNull(),
+				"read":   types.StringNull(),
+				"update": types.StringNull(),
+				"delete": types.StringValue("5m"),
+			},
+		),
+	}
+
+	op, diags := Delete(ctx, v)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := op.Wait(2 * time.Minute); got != 5*time.Minute {
+		t.Fatalf("expected the configured wait, got %s", got)
+	}
+
+	bounded, cancel := op.Context(ctx)
+	defer cancel()
+	if _, ok := bounded.Deadline(); !ok {
+		t.Fatal("expected a deadline with a configured timeout")
+	}
+
+	op, diags = Update(ctx, v)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := op.Wait(time.Minute); got != time.Minute {
+		t.Fatalf("expected the default for an unset operation, got %s", got)
+	}
+}
+
+func TestNullMatchesBlock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	want := Block().Type()
+	if got := Null().Type(ctx); !got.Equal(want) {
+		t.Fatalf("expected Null type %s to match the block type %s", got, want)
+	}
+}
@@ -4,14 +4,26 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/alertcontact"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/currentuser"
@@ -20,14 +32,18 @@ import (
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/maintenancewindow"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/monitor"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/monitorgroup"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/psp"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/pspannouncement"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/tag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/unmanagedobjects"
 )
 
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
 var _ provider.Provider = &UptimeRobotProvider{}
 var _ provider.ProviderWithFunctions = &UptimeRobotProvider{}
+var _ provider.ProviderWithActions = &UptimeRobotProvider{}
+var _ provider.ProviderWithEphemeralResources = &UptimeRobotProvider{}
 
 // UptimeRobotProvider defines the provider implementation.
 type UptimeRobotProvider struct {
@@ -36,8 +52,20 @@ type UptimeRobotProvider struct {
 
 // UptimeRobotProviderModel describes the provider data model.
 type UptimeRobotProviderModel struct {
-	APIKey types.String `tfsdk:"api_key"`
-	APIURL types.String `tfsdk:"api_url"`
+	APIKey                types.String `tfsdk:"api_key"`
+	APIURL                types.String `tfsdk:"api_url"`
+	ReadOnly              types.Bool   `tfsdk:"read_only"`
+	ProtectAll            types.Bool   `tfsdk:"protect_all"`
+	DuplicateMonitorCheck types.String `tfsdk:"duplicate_monitor_check"`
+	CreateTokens          types.Bool   `tfsdk:"create_idempotency_tokens"`
+	DefaultTags           types.Set    `tfsdk:"default_tags"`
+	DefaultCustomFields   types.Map    `tfsdk:"default_custom_fields"`
+	MonitorDefaults       types.Object `tfsdk:"monitor_defaults"`
+	OwnershipStamp        types.Object `tfsdk:"ownership_stamp"`
+	SubscriptionCheck     types.Object `tfsdk:"subscription_check"`
+
+	AllowedAccountEmails   types.Set `tfsdk:"allowed_account_emails"`
+	ForbiddenAccountEmails types.Set `tfsdk:"forbidden_account_emails"`
 }
 
 func (p *UptimeRobotProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -57,6 +85,237 @@ func (p *UptimeRobotProvider) Schema(ctx context.Context, req provider.SchemaReq
 				MarkdownDescription: "Optional API endpoint URL. If not specified, the default endpoint will be used. Can also be set via the `UPTIMEROBOT_API_URL` environment variable.",
 				Optional:            true,
 			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "When true, the provider only reads from UptimeRobot: plan and refresh work, and any apply that " +
+					"would create, update or delete something fails with a read-only mode error before a request is sent. " +
+					"Can also be set via the `UPTIMEROBOT_READ_ONLY` environment variable.",
+				Optional: true,
+			},
+			"protect_all": schema.BoolAttribute{
+				MarkdownDescription: "When true, monitors, monitor groups, status pages and integrations that do not set " +
+					"`deletion_protection` are protected from being destroyed.",
+				Optional: true,
+			},
+			"duplicate_monitor_check": schema.StringAttribute{
+				MarkdownDescription: "Checks planned monitors for duplicates: another monitor of the same type and URL in the plan " +
+					"or in the account. `warning` reports them as warnings and `error` fails the plan. Only creates and type or URL " +
+					"changes are checked. When omitted, no check is made.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("warning", "error"),
+				},
+			},
+			"create_idempotency_tokens": schema.BoolAttribute{
+				MarkdownDescription: "When true, every monitor and integration create carries a token derived from the create " +
+					"request and the `ownership_stamp`, so when a create fails after the object was stored, the failed " +
+					"create, a rerun or another process finds the object by its token and adopts it instead of leaving it " +
+					"behind or creating a duplicate. Monitors carry the token in a `tf_create_token` custom field that is " +
+					"hidden from `custom_fields`, and integrations in their name, until the create finishes.",
+				Optional: true,
+			},
+			"allowed_account_emails": schema.SetAttribute{
+				MarkdownDescription: "Emails of the UptimeRobot accounts the API key may belong to. When the key belongs to another " +
+					"account, every plan and apply fails. Conflicts with `forbidden_account_emails`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					setvalidator.ConflictsWith(path.MatchRoot("forbidden_account_emails")),
+				},
+			},
+			"forbidden_account_emails": schema.SetAttribute{
+				MarkdownDescription: "Emails of the UptimeRobot accounts the API key must not belong to. When it does, every plan " +
+					"and apply fails. Conflicts with `allowed_account_emails`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"default_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags added to every monitor, on top of the monitor's own `tags`. The combined tags are " +
+					"exposed as `tags_all` on the monitor. While this is set, tags outside `default_tags` and the monitor's " +
+					"`tags` are removed from monitors. Must be lowercase.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[^A-Z]+$`), "must be lowercase (ASCII)"),
+					),
+				},
+			},
+			"default_custom_fields": schema.MapAttribute{
+				MarkdownDescription: "Custom fields merged into every monitor. A monitor's own `custom_fields` win for the same key. " +
+					"The merged fields are exposed as `custom_fields_all` on the monitor. While this is set, custom fields " +
+					"outside `default_custom_fields` and the monitor's `custom_fields` are removed from monitors.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.LengthAtLeast(1),
+						stringvalidator.LengthAtMost(64),
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only letters, numbers, underscores, and hyphens"),
+					),
+					mapvalidator.ValueStringsAre(
+						stringvalidator.LengthAtMost(255),
+					),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ownership_stamp": schema.SingleNestedBlock{
+				MarkdownDescription: "A custom field set on every monitor this provider creates, e.g. `managed_by = terraform:prod`, " +
+					"so monitors created outside Terraform can be told apart. Monitors that already exist get the stamp on their next " +
+					"update. The stamp is left out of `custom_fields`, `custom_fields_all` and plans. See the `uptimerobot_unmanaged_objects` data source.",
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						MarkdownDescription: "Custom field key, e.g. `managed_by`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.LengthAtMost(64),
+							stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only letters, numbers, underscores, and hyphens"),
+						},
+					},
+					"value": schema.StringAttribute{
+						MarkdownDescription: "Custom field value, e.g. `terraform:prod`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.LengthAtMost(255),
+						},
+					},
+				},
+			},
+			"subscription_check": schema.SingleNestedBlock{
+				MarkdownDescription: "How planned monitors are checked against the account subscription. Creates beyond the " +
+					"monitor limit the API reports for the account are always checked. The API does not report the other limits " +
+					"of a plan, so intervals, monitor types and region selection are only checked against the limits set here.",
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						MarkdownDescription: "`warning` (the default) reports monitors that do not fit the subscription as " +
+							"warnings and `error` fails the plan.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("warning", "error"),
+						},
+					},
+					"min_interval": schema.Int64Attribute{
+						MarkdownDescription: "Shortest check interval in seconds the plan supports, e.g. `300` on the Free plan.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"monitor_types": schema.SetAttribute{
+						MarkdownDescription: "Monitor types the plan supports, e.g. `[\"HTTP\", \"KEYWORD\", \"PING\", \"PORT\"]` on the " +
+							"Free plan. When omitted, every type is allowed.",
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(
+								monitor.MonitorTypeHTTP, monitor.MonitorTypeKEYWORD, monitor.MonitorTypePING, monitor.MonitorTypePORT,
+								monitor.MonitorTypeHEARTBEAT, monitor.MonitorTypeDNS, monitor.MonitorTypeAPI, monitor.MonitorTypeUDP,
+							)),
+						},
+					},
+					"region_selection": schema.BoolAttribute{
+						MarkdownDescription: "Whether the plan supports selecting monitoring regions with `region_data` and " +
+							"`regional_data`. Set to `false` to report monitors that select them. When omitted, they are not checked.",
+						Optional: true,
+					},
+				},
+			},
+			"monitor_defaults": schema.SingleNestedBlock{
+				MarkdownDescription: "Values used for monitor attributes that a monitor leaves unset. Each monitor lists the " +
+					"attributes it took from here in `defaults_applied`. Attributes that do not apply to a monitor's type are " +
+					"skipped: `timeout` for HEARTBEAT and DNS monitors, `ssl_expiration_reminder` and `follow_redirections` for " +
+					"monitors other than HTTP, KEYWORD and API (and `ssl_expiration_reminder` for non-HTTPS URLs), and " +
+					"`region_data` for HEARTBEAT monitors and monitors that set `regional_data`.",
+				Attributes: map[string]schema.Attribute{
+					"interval": schema.Int64Attribute{
+						MarkdownDescription: "Default check interval in seconds. Monitors may omit `interval` when this is set.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(30),
+						},
+					},
+					"timeout": schema.Int64Attribute{
+						MarkdownDescription: "Default check timeout in seconds.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 60),
+						},
+					},
+					"assigned_alert_contacts": schema.SetNestedAttribute{
+						MarkdownDescription: "Default alert contacts, in the shape of the monitor `assigned_alert_contacts` attribute.",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"alert_contact_id": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a numeric ID"),
+									},
+								},
+								"threshold": schema.Int64Attribute{
+									MarkdownDescription: "Delay in minutes before notifying this contact.",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+									},
+								},
+								"recurrence": schema.Int64Attribute{
+									MarkdownDescription: "Repeat interval in minutes for subsequent notifications.",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+									},
+								},
+							},
+						},
+					},
+					"ssl_expiration_reminder": schema.BoolAttribute{
+						MarkdownDescription: "Default for `ssl_expiration_reminder`.",
+						Optional:            true,
+					},
+					"follow_redirections": schema.BoolAttribute{
+						MarkdownDescription: "Default for `follow_redirections`.",
+						Optional:            true,
+					},
+					"region_data": schema.SingleNestedAttribute{
+						MarkdownDescription: "Default multi-region settings, in the shape of the monitor `region_data` attribute.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"regions": schema.SetAttribute{
+								MarkdownDescription: "Active monitoring regions: `na`, `eu`, `as`, `oc`.",
+								Optional:            true,
+								ElementType:         types.StringType,
+								Validators: []validator.Set{
+									setvalidator.SizeAtLeast(1),
+									setvalidator.SizeAtMost(4),
+									setvalidator.ValueStringsAre(
+										stringvalidator.OneOf("na", "eu", "as", "oc"),
+									),
+								},
+							},
+							"auto_select": schema.BoolAttribute{
+								MarkdownDescription: "When true, UptimeRobot chooses the monitoring region.",
+								Optional:            true,
+							},
+							"thresholds": schema.MapAttribute{
+								MarkdownDescription: "Per-region response-time thresholds in milliseconds.",
+								Optional:            true,
+								ElementType:         types.Int64Type,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -64,6 +323,18 @@ func (p *UptimeRobotProvider) Schema(ctx context.Context, req provider.SchemaReq
 func (p *UptimeRobotProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	apiKey := os.Getenv("UPTIMEROBOT_API_KEY")
 	apiURL := os.Getenv("UPTIMEROBOT_API_URL")
+	readOnly := false
+	if v := strings.TrimSpace(os.Getenv("UPTIMEROBOT_READ_ONLY")); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid UPTIMEROBOT_READ_ONLY Value",
+				fmt.Sprintf("UPTIMEROBOT_READ_ONLY must be true or false, got %q.", v),
+			)
+			return
+		}
+		readOnly = parsed
+	}
 
 	var config UptimeRobotProviderModel
 
@@ -82,6 +353,9 @@ func (p *UptimeRobotProvider) Configure(ctx context.Context, req provider.Config
 	if !config.APIURL.IsNull() && !config.APIURL.IsUnknown() {
 		apiURL = config.APIURL.ValueString()
 	}
+	if !config.ReadOnly.IsNull() && !config.ReadOnly.IsUnknown() {
+		readOnly = config.ReadOnly.ValueBool()
+	}
 
 	if apiKey == "" {
 		resp.Diagnostics.AddError(
@@ -94,6 +368,8 @@ func (p *UptimeRobotProvider) Configure(ctx context.Context, req provider.Config
 	ua := fmt.Sprintf("terraform-provider-uptimerobot/%s Terraform/%s",
 		p.version, strings.TrimSpace(req.TerraformVersion))
 
+	// user is the account of the API key when the guardrail below reads it.
+	var user *client.CurrentUser
 	client := client.NewClient(apiKey)
 	client.SetUserAgent(ua)
 	client.AddHeader("X-Terraform-Provider", "uptimerobot/"+p.version)
@@ -103,15 +379,105 @@ func (p *UptimeRobotProvider) Configure(ctx context.Context, req provider.Config
 		apiURL = "https://api.uptimerobot.com/v3"
 	}
 	client.SetBaseURL(apiURL)
+	client.SetReadOnly(readOnly)
+
+	var allowedEmails, forbiddenEmails []string
+	if !config.AllowedAccountEmails.IsNull() && !config.AllowedAccountEmails.IsUnknown() {
+		resp.Diagnostics.Append(config.AllowedAccountEmails.ElementsAs(ctx, &allowedEmails, false)...)
+	}
+	if !config.ForbiddenAccountEmails.IsNull() && !config.ForbiddenAccountEmails.IsUnknown() {
+		resp.Diagnostics.Append(config.ForbiddenAccountEmails.ElementsAs(ctx, &forbiddenEmails, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Verify the account once here so that a key for the wrong account fails
+	// every plan and apply before anything is read or written.
+	if len(allowedEmails) > 0 || len(forbiddenEmails) > 0 {
+		var err error
+		user, err = client.GetCurrentUser(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Verify UptimeRobot Account",
+				"allowed_account_emails or forbidden_account_emails is set, but the account of the API key could not be read: "+err.Error(),
+			)
+			return
+		}
+		if detail := accountEmailMismatch(user.Email, allowedEmails, forbiddenEmails); detail != "" {
+			resp.Diagnostics.AddError("UptimeRobot Account Not Allowed", detail)
+			return
+		}
+	}
+
+	data := &providerclient.Data{
+		Client:          client,
+		MonitorDefaults: config.MonitorDefaults,
+		Account:         providerclient.NewAccount(client, user),
+		ProtectAll:      config.ProtectAll.ValueBool(),
+		Monitors:        providerclient.NewMonitorRegistry(client),
+
+		DuplicateMonitorCheck: config.DuplicateMonitorCheck.ValueString(),
+		SubscriptionCheck:     config.SubscriptionCheck,
+		CreateTokens:          config.CreateTokens.ValueBool(),
+	}
+	if !config.DefaultTags.IsNull() && !config.DefaultTags.IsUnknown() {
+		resp.Diagnostics.Append(config.DefaultTags.ElementsAs(ctx, &data.DefaultTags, false)...)
+	}
+	if !config.DefaultCustomFields.IsNull() && !config.DefaultCustomFields.IsUnknown() {
+		resp.Diagnostics.Append(config.DefaultCustomFields.ElementsAs(ctx, &data.DefaultCustomFields, false)...)
+	}
+	if !config.OwnershipStamp.IsNull() && !config.OwnershipStamp.IsUnknown() {
+		var stamp struct {
+			Key   types.String `tfsdk:"key"`
+			Value types.String `tfsdk:"value"`
+		}
+		resp.Diagnostics.Append(config.OwnershipStamp.As(ctx, &stamp, basetypes.ObjectAsOptions{})...)
+		data.OwnershipStamp = &providerclient.OwnershipStamp{Key: stamp.Key.ValueString(), Value: stamp.Value.ValueString()}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = data
+	resp.ResourceData = data
+	resp.ActionData = data
+	resp.EphemeralResourceData = data
+}
+
+// accountEmailMismatch describes why email fails allowed_account_emails or
+// forbidden_account_emails, or returns "" when it passes. Emails compare case
+// insensitively.
+func accountEmailMismatch(email string, allowed, forbidden []string) string {
+	matches := func(list []string) bool {
+		return slices.ContainsFunc(list, func(s string) bool {
+			return strings.EqualFold(strings.TrimSpace(s), strings.TrimSpace(email))
+		})
+	}
+	if len(allowed) > 0 && !matches(allowed) {
+		return fmt.Sprintf("The API key belongs to account %q, which is not in allowed_account_emails (%s). "+
+			"Check that the API key is meant for this configuration.", email, strings.Join(allowed, ", "))
+	}
+	if matches(forbidden) {
+		return fmt.Sprintf("The API key belongs to account %q, which is listed in forbidden_account_emails. "+
+			"Check that the API key is meant for this configuration.", email)
+	}
+	return ""
 }
 
 func (p *UptimeRobotProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		monitor.NewResource,
+		monitor.NewHTTPResource,
+		monitor.NewKeywordResource,
+		monitor.NewAPIResource,
+		monitor.NewPingResource,
+		monitor.NewPortResource,
+		monitor.NewUDPResource,
+		monitor.NewDNSResource,
+		monitor.NewHeartbeatResource,
 		monitorgroup.NewResource,
+		monitorgroup.NewMembershipResource,
+		tag.NewResource,
 		psp.NewResource,
 		pspannouncement.NewResource,
 		maintenancewindow.NewResource,
@@ -131,16 +497,35 @@ func (p *UptimeRobotProvider) DataSources(ctx context.Context) []func() datasour
 		maintenancewindow.NewDataSource,
 		monitor.NewDataSource,
 		monitor.NewListDataSource,
+		monitor.NewStatusDataSource,
 		monitorgroup.NewDataSource,
 		psp.NewDataSource,
 		pspannouncement.NewDataSource,
 		tag.NewDataSource,
 		tag.NewListDataSource,
+		unmanagedobjects.NewDataSource,
 	}
 }
 
 func (p *UptimeRobotProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		pspannouncement.NewContentHTMLFunction,
+	}
+}
+
+func (p *UptimeRobotProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		monitor.NewResetAction,
+		monitor.NewPauseAction,
+		monitor.NewResumeAction,
+		integration.NewTestNotificationAction,
+	}
+}
+
+func (p *UptimeRobotProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		maintenancewindow.NewDeployWindowEphemeralResource,
+	}
 }
 
 func New(version string) func() provider.Provider {
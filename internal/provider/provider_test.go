@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
-	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
 )
 
 // Provider-level tests.
@@ -40,10 +40,11 @@ func TestProviderConfigure_EnvironmentVariables(t *testing.T) {
 		t.Fatal("ResourceData is nil, provider client was not configured")
 	}
 
-	apiClient, ok := clientData.(*client.Client)
+	data, ok := clientData.(*providerclient.Data)
 	if !ok {
-		t.Fatalf("Failed to type assert ResourceData to *client.Client, got %T", clientData)
+		t.Fatalf("Failed to type assert ResourceData to *providerclient.Data, got %T", clientData)
 	}
+	apiClient := data.Client
 
 	if gotAPIKey := apiClient.ApiKey(); gotAPIKey != testAPIKey {
 		t.Errorf("Expected API key to be %s, but got %s", testAPIKey, gotAPIKey)
@@ -58,10 +59,11 @@ func TestProviderConfigure_EnvironmentVariables(t *testing.T) {
 
 	// Re-fetch the newly configured client instance.
 	clientData = resp.ResourceData
-	apiClient, ok = clientData.(*client.Client)
+	data, ok = clientData.(*providerclient.Data)
 	if !ok {
-		t.Fatalf("Failed to type assert ResourceData to *client.Client, got %T", clientData)
+		t.Fatalf("Failed to type assert ResourceData to *providerclient.Data, got %T", clientData)
 	}
+	apiClient = data.Client
 	if resp.Diagnostics.HasError() {
 		t.Fatalf("Provider.Configure() after clearing env failed with diagnostics: %v", resp.Diagnostics)
 	}
@@ -69,3 +71,56 @@ func TestProviderConfigure_EnvironmentVariables(t *testing.T) {
 		t.Errorf("Expected API URL to be the default, but got %s", gotAPIURL)
 	}
 }
+
+func TestAccountEmailMismatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		email     string
+		allowed   []string
+		forbidden []string
+		wantFail  bool
+	}{
+		{name: "no lists", email: "ops@example.com"},
+		{name: "allowed", email: "ops@example.com", allowed: []string{"OPS@example.com"}},
+		{name: "not allowed", email: "prod@example.com", allowed: []string{"staging@example.com"}, wantFail: true},
+		{name: "forbidden", email: "prod@example.com", forbidden: []string{"prod@example.com"}, wantFail: true},
+		{name: "not forbidden", email: "staging@example.com", forbidden: []string{"prod@example.com"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := accountEmailMismatch(tt.email, tt.allowed, tt.forbidden)
+			if (got != "") != tt.wantFail {
+				t.Fatalf("expected failure=%v, got %q", tt.wantFail, got)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_ReadOnlyFromEnvironment(t *testing.T) {
+	t.Setenv("UPTIMEROBOT_API_KEY", "test-api-key-from-env")
+	t.Setenv("UPTIMEROBOT_READ_ONLY", "true")
+
+	p := New("test")()
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Provider.Configure() failed with diagnostics: %v", resp.Diagnostics)
+	}
+	data, ok := resp.ResourceData.(*providerclient.Data)
+	if !ok || !data.Client.ReadOnly() {
+		t.Fatalf("expected a read-only client, got %#v", resp.ResourceData)
+	}
+
+	t.Setenv("UPTIMEROBOT_READ_ONLY", "maybe")
+	resp = &provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for an invalid UPTIMEROBOT_READ_ONLY value")
+	}
+}
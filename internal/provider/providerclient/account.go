@@ -0,0 +1,78 @@
+package providerclient
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+// Account caches the account of the API key, with its subscription limits.
+// It is read at most once per provider instance, on first use, so plans that
+// never consult it cost no extra request.
+type Account struct {
+	client *client.Client
+
+	once sync.Once
+	user *client.CurrentUser
+	err  error
+
+	mu sync.Mutex
+	// planned counts the monitor creates planned by this provider instance.
+	planned int64
+	// created counts monitors this provider instance created, and
+	// createdAtRead those of them created before user was read.
+	created       int64
+	createdAtRead int64
+}
+
+// NewAccount returns an Account for c. user may be the already read current
+// user, or nil to read it on first use.
+func NewAccount(c *client.Client, user *client.CurrentUser) *Account {
+	a := &Account{client: c}
+	if user != nil {
+		a.once.Do(func() { a.user = user })
+	}
+	return a
+}
+
+// User returns the current user of the API key.
+func (a *Account) User(ctx context.Context) (*client.CurrentUser, error) {
+	a.once.Do(func() {
+		a.user, a.err = a.client.GetCurrentUser(ctx)
+		a.mu.Lock()
+		a.createdAtRead = a.created
+		a.mu.Unlock()
+	})
+	return a.user, a.err
+}
+
+// PlanMonitorCreate records a planned monitor create and returns the number
+// of creates planned so far. Every create counts, whatever its name.
+//
+// Terraform plans each resource once per run phase, and configures the
+// provider again for every phase, so the plan made right before a create is
+// applied is counted by the Account of the apply phase, not a second time by
+// this one.
+func (a *Account) PlanMonitorCreate() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.planned++
+	return a.planned
+}
+
+// MonitorCreated records a monitor created by this provider instance.
+func (a *Account) MonitorCreated() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.created++
+}
+
+// MonitorsBeforeCreates returns the monitor count of user, which must come
+// from User, without the monitors this provider instance had already created
+// when it was read. Those are among the planned creates too.
+func (a *Account) MonitorsBeforeCreates(user *client.CurrentUser) int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return user.MonitorsCount - a.createdAtRead
+}
@@ -3,43 +3,110 @@ package providerclient
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
 )
 
-// FromDataSourceConfigure returns the configured API client for a data source.
-func FromDataSourceConfigure(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *client.Client {
-	if req.ProviderData == nil {
+// Data is the provider data handed to resources, data sources, actions and
+// ephemeral resources: the API client plus provider-level settings.
+type Data struct {
+	Client *client.Client
+
+	// DefaultTags are added to the tags of every monitor.
+	DefaultTags []string
+	// DefaultCustomFields are merged into the custom fields of every monitor.
+	// Resource-level values win.
+	DefaultCustomFields map[string]string
+	// MonitorDefaults is the monitor_defaults block, null when it is not set.
+	// The monitor package decodes it.
+	MonitorDefaults types.Object
+	// Account is the account of the API key, used for plan-time checks
+	// against its subscription.
+	Account *Account
+	// Monitors is shared by the monitor resources for checks across the
+	// whole plan.
+	Monitors *MonitorRegistry
+	// DuplicateMonitorCheck is "warning" or "error" when planned monitors are
+	// checked for duplicates, and empty when they are not.
+	DuplicateMonitorCheck string
+	// SubscriptionCheck is the subscription_check block, null when it is not
+	// set. The monitor package decodes it.
+	SubscriptionCheck types.Object
+	// CreateTokens enables create_idempotency_tokens: creates carry a token
+	// that lets a retry adopt the object an earlier attempt created.
+	CreateTokens bool
+	// OwnershipStamp is stamped on created monitors, nil when it is not set.
+	OwnershipStamp *OwnershipStamp
+	// ProtectAll protects every object with a deletion_protection attribute
+	// that does not set it.
+	ProtectAll bool
+}
+
+// OwnershipStamp is the custom field that marks monitors as created by this
+// configuration, e.g. managed_by=terraform:prod.
+type OwnershipStamp struct {
+	Key   string
+	Value string
+}
+
+// fromProviderData accepts *Data, or a bare *client.Client for callers that
+// configure a single component.
+func fromProviderData(providerData any, summary string, diags *diag.Diagnostics) *Data {
+	switch data := providerData.(type) {
+	case nil:
+		return nil
+	case *Data:
+		return data
+	case *client.Client:
+		return &Data{Client: data}
+	default:
+		diags.AddError(summary, fmt.Sprintf("Expected *providerclient.Data, got: %T", providerData))
 		return nil
 	}
+}
 
-	client, ok := req.ProviderData.(*client.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
-		)
+func clientOf(data *Data) *client.Client {
+	if data == nil {
 		return nil
 	}
+	return data.Client
+}
+
+// FromDataSourceConfigure returns the configured API client for a data source.
+func FromDataSourceConfigure(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *client.Client {
+	return clientOf(DataFromDataSourceConfigure(req, resp))
+}
+
+// DataFromDataSourceConfigure returns the provider data for a data source
+// that also needs provider-level settings. It is nil until the provider is
+// configured.
+func DataFromDataSourceConfigure(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *Data {
+	return fromProviderData(req.ProviderData, "Unexpected Data Source Configure Type", &resp.Diagnostics)
+}
+
+// FromActionConfigure returns the configured API client for an action.
+func FromActionConfigure(req action.ConfigureRequest, resp *action.ConfigureResponse) *client.Client {
+	return clientOf(fromProviderData(req.ProviderData, "Unexpected Action Configure Type", &resp.Diagnostics))
+}
 
-	return client
+// FromEphemeralResourceConfigure returns the configured API client for an
+// ephemeral resource.
+func FromEphemeralResourceConfigure(req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) *client.Client {
+	return clientOf(fromProviderData(req.ProviderData, "Unexpected Ephemeral Resource Configure Type", &resp.Diagnostics))
 }
 
 // FromResourceConfigure returns the configured API client for a resource.
 func FromResourceConfigure(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *client.Client {
-	if req.ProviderData == nil {
-		return nil
-	}
-
-	client, ok := req.ProviderData.(*client.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
-		)
-		return nil
-	}
+	return clientOf(DataFromResourceConfigure(req, resp))
+}
 
-	return client
+// DataFromResourceConfigure returns the provider data for a resource that also
+// needs provider-level settings. It is nil until the provider is configured.
+func DataFromResourceConfigure(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *Data {
+	return fromProviderData(req.ProviderData, "Unexpected Resource Configure Type", &resp.Diagnostics)
 }
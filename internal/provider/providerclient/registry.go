@@ -0,0 +1,103 @@
+package providerclient
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+// PlannedMonitor is a monitor seen by a plan. ID is empty for monitors that
+// are being created.
+type PlannedMonitor struct {
+	ID   string
+	Name string
+	Type string
+	URL  string
+}
+
+// MonitorRegistry is shared by all monitor resource instances of a provider
+// instance, so plan-time checks can compare monitors across the whole
+// configuration. The account monitors are listed on first use; a failed
+// listing is not kept, so the next check tries again.
+type MonitorRegistry struct {
+	client *client.Client
+
+	// loadMu serializes listing, so concurrent checks wait for one listing
+	// instead of each starting their own.
+	loadMu sync.Mutex
+
+	mu       sync.Mutex
+	loaded   bool
+	existing []client.Monitor
+	planned  []PlannedMonitor
+}
+
+// NewMonitorRegistry returns an empty MonitorRegistry for c.
+func NewMonitorRegistry(c *client.Client) *MonitorRegistry {
+	return &MonitorRegistry{client: c}
+}
+
+// Existing returns the monitors in the account.
+func (r *MonitorRegistry) Existing(ctx context.Context) ([]client.Monitor, error) {
+	r.loadMu.Lock()
+	defer r.loadMu.Unlock()
+
+	r.mu.Lock()
+	loaded, existing := r.loaded, r.existing
+	r.mu.Unlock()
+	if loaded {
+		return existing, nil
+	}
+
+	existing, err := r.client.GetMonitors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.existing, r.loaded = existing, true
+	r.mu.Unlock()
+	return existing, nil
+}
+
+// Plan records m and returns the other monitors recorded before it.
+// Recording an existing monitor again replaces its earlier record; every
+// create is a record of its own, whatever its name, since Terraform plans each
+// resource once per provider instance.
+//
+// Monitors planned after m are not returned, so a pair of duplicates in the
+// same plan is reported by whichever of the two is planned second. The order
+// decides which resource carries the report, not whether there is one:
+// monitors that already exist are also compared through Existing.
+func (r *MonitorRegistry) Plan(m PlannedMonitor) []PlannedMonitor {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	others := make([]PlannedMonitor, 0, len(r.planned))
+	for _, p := range r.planned {
+		if m.ID != "" && p.ID == m.ID {
+			continue
+		}
+		others = append(others, p)
+	}
+	r.planned = append(slices.Clone(others), m)
+	return others
+}
+
+// Created records the ID of a monitor created by this provider instance, in
+// place of the record its plan left without one, so a later listing of the
+// account does not report it a second time.
+func (r *MonitorRegistry) Created(m PlannedMonitor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	planned := slices.Clone(r.planned)
+	i := slices.IndexFunc(planned, func(p PlannedMonitor) bool {
+		return p.ID == "" && p.Name == m.Name && p.Type == m.Type && p.URL == m.URL
+	})
+	if i >= 0 {
+		planned = slices.Delete(planned, i, i+1)
+	}
+	r.planned = append(planned, m)
+}
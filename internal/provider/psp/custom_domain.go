@@ -0,0 +1,177 @@
+package psp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+// pspCustomDomainCNAMETarget is the hostname custom domains point at when the API
+// does not return explicit verification records.
+const pspCustomDomainCNAMETarget = "stats.uptimerobot.com"
+
+const pspCustomDomainStatusVerified = "verified"
+
+func pspCustomDomainDNSRecordObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  types.StringType,
+			"type":  types.StringType,
+			"value": types.StringType,
+		},
+	}
+}
+
+// pspCustomDomainDNSRecords returns the DNS records needed to point the PSP custom domain at UptimeRobot.
+func pspCustomDomainDNSRecords(psp *client.PSP) []client.PSPDNSRecord {
+	if len(psp.CustomDomainDNSRecords) > 0 {
+		return psp.CustomDomainDNSRecords
+	}
+	if psp.CustomDomain == nil || strings.TrimSpace(*psp.CustomDomain) == "" {
+		return nil
+	}
+	return []client.PSPDNSRecord{{
+		Name:  strings.TrimSpace(*psp.CustomDomain),
+		Type:  "CNAME",
+		Value: pspCustomDomainCNAMETarget,
+	}}
+}
+
+func pspCustomDomainDNSRecordsValue(psp *client.PSP) types.List {
+	records := pspCustomDomainDNSRecords(psp)
+	objectType := pspCustomDomainDNSRecordObjectType()
+	if len(records) == 0 {
+		return types.ListNull(objectType)
+	}
+
+	values := make([]attr.Value, 0, len(records))
+	for _, record := range records {
+		values = append(values, types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+			"name":  types.StringValue(record.Name),
+			"type":  types.StringValue(strings.ToUpper(record.Type)),
+			"value": types.StringValue(record.Value),
+		}))
+	}
+	return types.ListValueMust(objectType, values)
+}
+
+func pspCustomDomainStatusValue(psp *client.PSP) types.String {
+	if psp.CustomDomain == nil || strings.TrimSpace(*psp.CustomDomain) == "" {
+		return types.StringNull()
+	}
+	if psp.CustomDomainStatus == nil || strings.TrimSpace(*psp.CustomDomainStatus) == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(normalizePSPCustomDomainStatus(*psp.CustomDomainStatus))
+}
+
+func normalizePSPCustomDomainStatus(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// pspCustomDomainVerificationTimeout returns the configured verification timeout, if any.
+func pspCustomDomainVerificationTimeout(plan pspResourceModel) (time.Duration, bool) {
+	if !hasConfiguredString(plan.WaitForCustomDomainVerification) || !hasConfiguredString(plan.CustomDomain) {
+		return 0, false
+	}
+	timeout, err := time.ParseDuration(plan.WaitForCustomDomainVerification.ValueString())
+	if err != nil || timeout <= 0 {
+		return 0, false
+	}
+	return timeout, true
+}
+
+// waitPSPCustomDomainVerified polls the PSP until its custom domain is verified.
+// It returns the most recent PSP snapshot, including on timeout. The status is
+// read from customDomainStatus, which not every API version returns, so a
+// first read without a status fails at once instead of polling for a status
+// that never comes.
+func waitPSPCustomDomainVerified(ctx context.Context, c *client.Client, id int64, timeout time.Duration) (*client.PSP, error) {
+	deadline := time.Now().Add(timeout)
+	backoff := 2 * time.Second
+	var last *client.PSP
+
+	for first := true; ; first = false {
+		psp, err := c.GetPSP(ctx, id)
+		if err != nil {
+			return last, fmt.Errorf("error reading PSP %d while waiting for custom domain verification: %w", id, err)
+		}
+		last = psp
+
+		status := ""
+		if psp.CustomDomainStatus != nil {
+			status = normalizePSPCustomDomainStatus(*psp.CustomDomainStatus)
+		}
+		switch status {
+		case "":
+			if first {
+				return psp, fmt.Errorf("the API did not report a verification status for the custom domain of PSP %d, "+
+					"so its verification cannot be waited for; remove wait_for_custom_domain_verification", id)
+			}
+		case pspCustomDomainStatusVerified:
+			return psp, nil
+		case "failed":
+			return psp, fmt.Errorf("custom domain verification failed for PSP %d; check the records in custom_domain_dns_records", id)
+		}
+
+		if time.Now().After(deadline) {
+			if status == "" {
+				return psp, fmt.Errorf("timeout after %s waiting for custom domain verification of PSP %d; the API did not report a verification status", timeout, id)
+			}
+			return psp, fmt.Errorf("timeout after %s waiting for custom domain verification of PSP %d; last status %q", timeout, id, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return last, fmt.Errorf("waiting for custom domain verification of PSP %d: %w", id, ctx.Err())
+		case <-time.After(backoff):
+		}
+		if backoff < 30*time.Second {
+			backoff *= 2
+			if backoff > 30*time.Second {
+				backoff = 30 * time.Second
+			}
+		}
+	}
+}
+
+// preservePlannedCustomDomainValues keeps known planned custom domain outputs so a status change
+// observed between plan and apply does not produce an inconsistent result. Read picks it up later.
+func preservePlannedCustomDomainValues(plan pspResourceModel, state *pspResourceModel) {
+	if !plan.CustomDomainStatus.IsUnknown() {
+		state.CustomDomainStatus = plan.CustomDomainStatus
+	}
+	if !plan.CustomDomainDNSRecords.IsUnknown() {
+		state.CustomDomainDNSRecords = plan.CustomDomainDNSRecords
+	}
+}
+
+type pspDurationValidator struct{}
+
+func (pspDurationValidator) Description(context.Context) string {
+	return "value must be a positive duration such as 30s, 10m, or 1h"
+}
+
+func (v pspDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (pspDurationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	timeout, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || timeout <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("%q must be a positive duration such as 30s, 10m, or 1h.", req.ConfigValue.ValueString()),
+		)
+	}
+}
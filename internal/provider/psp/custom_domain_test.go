@@ -0,0 +1,162 @@
+package psp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+func TestPSPCustomDomainDNSRecords(t *testing.T) {
+	t.Parallel()
+
+	domain := "status.example.com"
+	if records := pspCustomDomainDNSRecords(&client.PSP{}); records != nil {
+		t.Fatalf("expected no records without a custom domain, got %#v", records)
+	}
+
+	fallback := pspCustomDomainDNSRecords(&client.PSP{CustomDomain: &domain})
+	if len(fallback) != 1 || fallback[0] != (client.PSPDNSRecord{Name: domain, Type: "CNAME", Value: pspCustomDomainCNAMETarget}) {
+		t.Fatalf("unexpected fallback records %#v", fallback)
+	}
+
+	apiRecords := []client.PSPDNSRecord{
+		{Name: domain, Type: "cname", Value: "custom.uptimerobot.com"},
+		{Name: "_verify." + domain, Type: "TXT", Value: "token"},
+	}
+	value := pspCustomDomainDNSRecordsValue(&client.PSP{CustomDomain: &domain, CustomDomainDNSRecords: apiRecords})
+	if len(value.Elements()) != 2 {
+		t.Fatalf("expected API records to be used, got %s", value)
+	}
+	if got := value.String(); !strings.Contains(got, `"type":"CNAME"`) || !strings.Contains(got, `"value":"token"`) {
+		t.Fatalf("unexpected records value %s", got)
+	}
+
+	if !pspCustomDomainDNSRecordsValue(&client.PSP{}).IsNull() {
+		t.Fatal("expected null records without a custom domain")
+	}
+}
+
+func TestPSPCustomDomainStatusValue(t *testing.T) {
+	t.Parallel()
+
+	domain := "status.example.com"
+	status := " Verified "
+	if got := pspCustomDomainStatusValue(&client.PSP{CustomDomain: &domain, CustomDomainStatus: &status}); got.ValueString() != "verified" {
+		t.Fatalf("expected normalized status, got %s", got)
+	}
+	if got := pspCustomDomainStatusValue(&client.PSP{CustomDomainStatus: &status}); !got.IsNull() {
+		t.Fatalf("expected null status without a custom domain, got %s", got)
+	}
+	if got := pspCustomDomainStatusValue(&client.PSP{CustomDomain: &domain}); !got.IsNull() {
+		t.Fatalf("expected null status when the API omits it, got %s", got)
+	}
+}
+
+func TestPSPCustomDomainVerificationTimeout(t *testing.T) {
+	t.Parallel()
+
+	plan := pspResourceModel{
+		CustomDomain:                    types.StringValue("status.example.com"),
+		WaitForCustomDomainVerification: types.StringValue("15m"),
+	}
+	if timeout, ok := pspCustomDomainVerificationTimeout(plan); !ok || timeout != 15*time.Minute {
+		t.Fatalf("expected 15m timeout, got %s %t", timeout, ok)
+	}
+
+	plan.CustomDomain = types.StringNull()
+	if _, ok := pspCustomDomainVerificationTimeout(plan); ok {
+		t.Fatal("expected no wait without a custom domain")
+	}
+}
+
+func TestWaitPSPCustomDomainVerified(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{name: "verified", body: `{"id":7,"customDomain":"status.example.com","customDomainStatus":"VERIFIED"}`},
+		{name: "failed", body: `{"id":7,"customDomain":"status.example.com","customDomainStatus":"failed"}`, wantErr: "verification failed"},
+		{name: "pending", body: `{"id":7,"customDomain":"status.example.com","customDomainStatus":"pending"}`, wantErr: `last status "pending"`},
+		{name: "no status", body: `{"id":7,"customDomain":"status.example.com"}`, wantErr: "did not report a verification status"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			t.Cleanup(server.Close)
+
+			c := client.NewClient("test-api-key")
+			c.SetBaseURL(server.URL)
+
+			psp, err := waitPSPCustomDomainVerified(context.Background(), c, 7, time.Nanosecond)
+			if psp == nil || psp.ID != 7 {
+				t.Fatalf("expected the last PSP snapshot, got %#v", psp)
+			}
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPSPDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	for value, wantErr := range map[string]bool{"10m": false, "1h30m": false, "0s": true, "ten minutes": true} {
+		resp := &validator.StringResponse{}
+		pspDurationValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("wait_for_custom_domain_verification"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+		if resp.Diagnostics.HasError() != wantErr {
+			t.Errorf("value %q: expected error=%t, got %v", value, wantErr, resp.Diagnostics)
+		}
+	}
+}
+
+func TestWaitPSPCustomDomainVerifiedFailsFastWithoutStatus(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":7,"customDomain":"status.example.com"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := client.NewClient("test-api-key")
+	c.SetBaseURL(server.URL)
+
+	_, err := waitPSPCustomDomainVerified(context.Background(), c, 7, time.Hour)
+	if err == nil || !strings.Contains(err.Error(), "remove wait_for_custom_domain_verification") {
+		t.Fatalf("expected an error that the status cannot be waited for, got %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("expected a single read, got %d", got)
+	}
+}
@@ -36,6 +36,8 @@ type pspDataSourceModel struct {
 	ID                         types.String         `tfsdk:"id"`
 	Name                       types.String         `tfsdk:"name"`
 	CustomDomain               types.String         `tfsdk:"custom_domain"`
+	CustomDomainDNSRecords     types.List           `tfsdk:"custom_domain_dns_records"`
+	CustomDomainStatus         types.String         `tfsdk:"custom_domain_status"`
 	IsPasswordSet              types.Bool           `tfsdk:"is_password_set"`
 	AutoAddMonitors            types.Bool           `tfsdk:"auto_add_monitors"`
 	MonitorIDs                 types.Set            `tfsdk:"monitor_ids"`
@@ -93,6 +95,30 @@ func (d *pspDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 				Computed:            true,
 				MarkdownDescription: "Custom domain configured for the PSP, or null when no custom domain is set.",
 			},
+			"custom_domain_dns_records": datasourceschema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "DNS records needed so `custom_domain` resolves to the status page, or null when no custom domain is set.",
+				NestedObject: datasourceschema.NestedAttributeObject{
+					Attributes: map[string]datasourceschema.Attribute{
+						"name": datasourceschema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Record name (the custom domain).",
+						},
+						"type": datasourceschema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Record type, for example `CNAME`.",
+						},
+						"value": datasourceschema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Record value.",
+						},
+					},
+				},
+			},
+			"custom_domain_status": datasourceschema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Verification status of `custom_domain` as reported by the API, or null when unavailable.",
+			},
 			"is_password_set": datasourceschema.BoolAttribute{
 				Computed:            true,
 				MarkdownDescription: "Whether a password is set for the PSP. The password value itself is not returned by the UptimeRobot API.",
@@ -408,6 +434,8 @@ func pspDataSourceState(ctx context.Context, statusPage *client.PSP) (pspDataSou
 		ID:                         resourceState.ID,
 		Name:                       resourceState.Name,
 		CustomDomain:               resourceState.CustomDomain,
+		CustomDomainDNSRecords:     resourceState.CustomDomainDNSRecords,
+		CustomDomainStatus:         resourceState.CustomDomainStatus,
 		IsPasswordSet:              resourceState.IsPasswordSet,
 		AutoAddMonitors:            resourceState.AutoAddMonitors,
 		MonitorIDs:                 monitorIDs,
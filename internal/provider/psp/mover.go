@@ -0,0 +1,123 @@
+package psp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/legacyprovider"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/tfconv"
+)
+
+// legacyStatusPageTypeName is the louy/uptimerobot name of a PSP.
+const legacyStatusPageTypeName = "uptimerobot_status_page"
+
+// legacyStatusPageModel is the part of the louy/uptimerobot
+// uptimerobot_status_page state that carries over to API v3.
+type legacyStatusPageModel struct {
+	ID           types.String `tfsdk:"id"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	CustomDomain types.String `tfsdk:"custom_domain"`
+	Password     types.String `tfsdk:"password"`
+	Sort         types.String `tfsdk:"sort"`
+	Status       types.String `tfsdk:"status"`
+	Monitors     types.List   `tfsdk:"monitors"`
+}
+
+func legacyStatusPageSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":            schema.StringAttribute{Computed: true},
+			"friendly_name": schema.StringAttribute{Required: true},
+			"custom_domain": schema.StringAttribute{Optional: true},
+			"password":      schema.StringAttribute{Optional: true, Sensitive: true},
+			"sort":          schema.StringAttribute{Optional: true},
+			"status":        schema.StringAttribute{Optional: true},
+			"monitors":      schema.ListAttribute{Optional: true, ElementType: types.Int64Type},
+		},
+	}
+}
+
+// legacyStatusPageSorts maps v2 sort names to monitor_sort values.
+var legacyStatusPageSorts = map[string]string{
+	"a-z":            "friendly_name_asc",
+	"z-a":            "friendly_name_desc",
+	"up-down-paused": "status_up_down_paused",
+	"down-up-paused": "status_down_up_paused",
+}
+
+// MoveState moves uptimerobot_status_page resources of the community
+// louy/uptimerobot provider to this resource.
+func (r *pspResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: movePSPFromLegacy},
+	}
+}
+
+func movePSPFromLegacy(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	source := legacyprovider.SourceState(ctx, req, legacyStatusPageTypeName, legacyStatusPageSchema(), &resp.Diagnostics)
+	if source == nil {
+		return
+	}
+
+	var legacy legacyStatusPageModel
+	resp.Diagnostics.Append(source.Get(ctx, &legacy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	moved, diags := pspFromLegacy(ctx, legacy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, moved)...)
+}
+
+// pspFromLegacy translates v2 status page state. The v2 monitor list used 0
+// for "all monitors", which monitor_ids still does.
+func pspFromLegacy(ctx context.Context, legacy legacyStatusPageModel) (pspResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	moved := pspResourceModel{
+		ID:                     legacy.ID,
+		Name:                   legacy.FriendlyName,
+		CustomDomain:           legacyEmptyToNull(legacy.CustomDomain),
+		CustomDomainDNSRecords: types.ListNull(pspCustomDomainDNSRecordObjectType()),
+		Password:               legacyEmptyToNull(legacy.Password),
+		TagIDs:                 types.SetNull(types.Int64Type),
+		PasswordWO:             types.StringNull(),
+		PasswordWOVersion:      types.Int64Null(),
+		Timeouts:               optimeout.Null(),
+	}
+
+	monitorIDs, d := tfconv.Int64ListToSet(ctx, legacy.Monitors)
+	diags.Append(d...)
+	moved.MonitorIDs = monitorIDs
+	autoAddMonitors, d := pspAutoAddMonitorsValue(ctx, monitorIDs)
+	diags.Append(d...)
+	moved.AutoAddMonitors = autoAddMonitors
+
+	if sort, ok := legacyStatusPageSorts[strings.ToLower(legacy.Sort.ValueString())]; ok {
+		moved.MonitorSort = types.StringValue(sort)
+	}
+	switch strings.ToLower(legacy.Status.ValueString()) {
+	case "active":
+		moved.Status = types.StringValue("ENABLED")
+	case "paused":
+		moved.Status = types.StringValue("PAUSED")
+	}
+
+	return moved, diags
+}
+
+func legacyEmptyToNull(v types.String) types.String {
+	if v.ValueString() == "" {
+		return types.StringNull()
+	}
+	return v
+}
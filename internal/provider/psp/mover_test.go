@@ -0,0 +1,60 @@
+package psp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/legacyprovider"
+)
+
+func TestMovePSPFromLegacy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&pspResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+
+	resp := resource.MoveStateResponse{
+		TargetState: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	movePSPFromLegacy(ctx, resource.MoveStateRequest{
+		SourceProviderAddress: legacyprovider.Address,
+		SourceTypeName:        legacyStatusPageTypeName,
+		SourceRawState: &tfprotov6.RawState{JSON: []byte(`{
+			"id": "42", "friendly_name": "Status", "custom_domain": "", "password": "",
+			"sort": "down-up-paused", "status": "paused", "monitors": [0],
+			"dns_address": "stats.uptimerobot.com", "standard_url": "https://stats.uptimerobot.com/abc"
+		}`)},
+	}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "move diags: %+v", resp.Diagnostics)
+
+	var moved pspResourceModel
+	require.False(t, resp.TargetState.Get(ctx, &moved).HasError())
+	require.Equal(t, "42", moved.ID.ValueString())
+	require.Equal(t, "Status", moved.Name.ValueString())
+	require.True(t, moved.CustomDomain.IsNull())
+	require.True(t, moved.Password.IsNull())
+	require.Equal(t, "status_down_up_paused", moved.MonitorSort.ValueString())
+	require.Equal(t, "PAUSED", moved.Status.ValueString())
+	require.True(t, moved.AutoAddMonitors.ValueBool())
+}
+
+func TestMovePSPFromLegacy_IgnoresOtherSources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resp := resource.MoveStateResponse{}
+	movePSPFromLegacy(ctx, resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/uptimerobot/uptimerobot",
+		SourceTypeName:        legacyStatusPageTypeName,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"id":"42"}`)},
+	}, &resp)
+	require.False(t, resp.Diagnostics.HasError())
+	require.True(t, resp.TargetState.Raw.IsNull())
+}
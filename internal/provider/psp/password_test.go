@@ -0,0 +1,47 @@
+package psp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPSPPasswordWOForUpdate(t *testing.T) {
+	t.Parallel()
+
+	config := pspResourceModel{PasswordWO: types.StringValue("secret")}
+	versioned := func(v types.Int64) pspResourceModel {
+		return pspResourceModel{PasswordWOVersion: v}
+	}
+
+	tests := []struct {
+		name   string
+		plan   pspResourceModel
+		state  pspResourceModel
+		config pspResourceModel
+		want   string
+	}{
+		{name: "unchanged version", plan: versioned(types.Int64Value(1)), state: versioned(types.Int64Value(1)), config: config},
+		{name: "changed version", plan: versioned(types.Int64Value(2)), state: versioned(types.Int64Value(1)), config: config, want: "secret"},
+		{name: "first version", plan: versioned(types.Int64Value(1)), state: versioned(types.Int64Null()), config: config, want: "secret"},
+		{name: "no write-only password", plan: versioned(types.Int64Null()), state: versioned(types.Int64Value(1)), config: pspResourceModel{PasswordWO: types.StringNull()}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := pspPasswordWOForUpdate(tt.plan, tt.state, tt.config)
+			if tt.want == "" {
+				if got != nil {
+					t.Fatalf("expected no password to be sent, got %q", *got)
+				}
+				return
+			}
+			if got == nil || *got != tt.want {
+				t.Fatalf("expected password %q to be sent, got %v", tt.want, got)
+			}
+		})
+	}
+}
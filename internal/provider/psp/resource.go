@@ -9,6 +9,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -22,6 +24,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/deletionprotection"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
 )
 
@@ -31,8 +36,16 @@ var (
 	_ resource.ResourceWithConfigure    = &pspResource{}
 	_ resource.ResourceWithImportState  = &pspResource{}
 	_ resource.ResourceWithUpgradeState = &pspResource{}
+	_ resource.ResourceWithMoveState    = &pspResource{}
+	_ resource.ResourceWithModifyPlan   = &pspResource{}
 )
 
+// pspAPIFieldNames maps PSP request fields whose names differ from the attribute names.
+var pspAPIFieldNames = apidiag.FieldNames{
+	"friendlyName": "name",
+	"sort":         "monitor_sort",
+}
+
 // NewResource is a helper function to simplify the provider implementation.
 func NewResource() resource.Resource {
 	return &pspResource{}
@@ -40,39 +53,57 @@ func NewResource() resource.Resource {
 
 // pspResource is the resource implementation.
 type pspResource struct {
-	client *client.Client
+	client     *client.Client
+	protectAll bool
 }
 
 // pspResourceModel maps the resource schema data.
 type pspResourceModel struct {
-	ID                         types.String         `tfsdk:"id"`
-	Name                       types.String         `tfsdk:"name"`
-	CustomDomain               types.String         `tfsdk:"custom_domain"`
-	Password                   types.String         `tfsdk:"password"`
-	IsPasswordSet              types.Bool           `tfsdk:"is_password_set"`
-	AutoAddMonitors            types.Bool           `tfsdk:"auto_add_monitors"`
-	MonitorIDs                 types.Set            `tfsdk:"monitor_ids"`
-	TagIDs                     types.Set            `tfsdk:"tag_ids"`
-	MonitorSort                types.String         `tfsdk:"monitor_sort"`
-	MonitorsCount              types.Int64          `tfsdk:"monitors_count"`
-	Status                     types.String         `tfsdk:"status"`
-	URLKey                     types.String         `tfsdk:"url_key"`
-	HomepageLink               types.String         `tfsdk:"homepage_link"`
-	GACode                     types.String         `tfsdk:"ga_code"`
-	ShareAnalyticsConsent      types.Bool           `tfsdk:"share_analytics_consent"`
-	UseSmallCookieConsentModal types.Bool           `tfsdk:"use_small_cookie_consent_modal"`
-	Icon                       types.String         `tfsdk:"icon"`
-	NoIndex                    types.Bool           `tfsdk:"no_index"`
-	Logo                       types.String         `tfsdk:"logo"`
-	HideURLLinks               types.Bool           `tfsdk:"hide_url_links"`
-	Subscription               types.Bool           `tfsdk:"subscription"`
-	ShowCookieBar              types.Bool           `tfsdk:"show_cookie_bar"`
-	PinnedAnnouncementID       types.Int64          `tfsdk:"pinned_announcement_id"`
-	CustomSettings             *customSettingsModel `tfsdk:"custom_settings"`
+	ID                              types.String         `tfsdk:"id"`
+	DeletionProtection              types.Bool           `tfsdk:"deletion_protection"`
+	Name                            types.String         `tfsdk:"name"`
+	CustomDomain                    types.String         `tfsdk:"custom_domain"`
+	CustomDomainDNSRecords          types.List           `tfsdk:"custom_domain_dns_records"`
+	CustomDomainStatus              types.String         `tfsdk:"custom_domain_status"`
+	WaitForCustomDomainVerification types.String         `tfsdk:"wait_for_custom_domain_verification"`
+	Password                        types.String         `tfsdk:"password"`
+	IsPasswordSet                   types.Bool           `tfsdk:"is_password_set"`
+	AutoAddMonitors                 types.Bool           `tfsdk:"auto_add_monitors"`
+	MonitorIDs                      types.Set            `tfsdk:"monitor_ids"`
+	TagIDs                          types.Set            `tfsdk:"tag_ids"`
+	MonitorSort                     types.String         `tfsdk:"monitor_sort"`
+	MonitorsCount                   types.Int64          `tfsdk:"monitors_count"`
+	Status                          types.String         `tfsdk:"status"`
+	URLKey                          types.String         `tfsdk:"url_key"`
+	HomepageLink                    types.String         `tfsdk:"homepage_link"`
+	GACode                          types.String         `tfsdk:"ga_code"`
+	ShareAnalyticsConsent           types.Bool           `tfsdk:"share_analytics_consent"`
+	UseSmallCookieConsentModal      types.Bool           `tfsdk:"use_small_cookie_consent_modal"`
+	Icon                            types.String         `tfsdk:"icon"`
+	NoIndex                         types.Bool           `tfsdk:"no_index"`
+	Logo                            types.String         `tfsdk:"logo"`
+	HideURLLinks                    types.Bool           `tfsdk:"hide_url_links"`
+	Subscription                    types.Bool           `tfsdk:"subscription"`
+	ShowCookieBar                   types.Bool           `tfsdk:"show_cookie_bar"`
+	PinnedAnnouncementID            types.Int64          `tfsdk:"pinned_announcement_id"`
+	CustomSettings                  *customSettingsModel `tfsdk:"custom_settings"`
+	Timeouts                        timeouts.Value       `tfsdk:"timeouts"`
+
+	// Write-only password. Terraform keeps it null in plan and state; see
+	// pspPasswordWOForUpdate.
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
 }
 
 const pspAutoAddMonitorID int64 = 0
 
+// Default waits used when the timeouts block does not override them.
+const (
+	pspSettleTimeout     = 120 * time.Second
+	pspReadSettleTimeout = 20 * time.Second
+	pspDeleteTimeout     = 2 * time.Minute
+)
+
 type pspMonitorSelection struct {
 	hasPlan              bool
 	configuredMonitorIDs bool
@@ -118,6 +149,15 @@ func hasConfiguredString(v types.String) bool {
 	return !v.IsNull() && !v.IsUnknown()
 }
 
+// pspPasswordWOForUpdate returns password_wo when an update must send it,
+// which is only when password_wo_version changed.
+func pspPasswordWOForUpdate(plan, state, config pspResourceModel) *string {
+	if config.PasswordWO.IsNull() || config.PasswordWO.IsUnknown() || plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		return nil
+	}
+	return config.PasswordWO.ValueStringPointer()
+}
+
 func hasConfiguredBool(v types.Bool) bool {
 	return !v.IsNull() && !v.IsUnknown()
 }
@@ -264,6 +304,12 @@ func ensureKnownTopLevelOptionals(state *pspResourceModel) {
 	if state.CustomDomain.IsUnknown() {
 		state.CustomDomain = types.StringNull()
 	}
+	if state.CustomDomainDNSRecords.IsUnknown() {
+		state.CustomDomainDNSRecords = types.ListNull(pspCustomDomainDNSRecordObjectType())
+	}
+	if state.CustomDomainStatus.IsUnknown() {
+		state.CustomDomainStatus = types.StringNull()
+	}
 	if state.HomepageLink.IsUnknown() {
 		state.HomepageLink = types.StringNull()
 	}
@@ -532,7 +578,12 @@ func maskCustomSettingsFromPriorState(prior *pspResourceModel, next *pspResource
 
 // Configure adds the provider configured client to the resource.
 func (r *pspResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerclient.FromResourceConfigure(req, resp)
+	data := providerclient.DataFromResourceConfigure(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+	r.protectAll = data.ProtectAll
 }
 
 // Metadata returns the resource type name.
@@ -546,6 +597,7 @@ func (r *pspResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 		Version:     1,
 		Description: "Manages a UptimeRobot Public Status Page (PSP).",
 		Attributes: map[string]schema.Attribute{
+			deletionprotection.AttributeName: deletionprotection.Attribute(),
 			"id": schema.StringAttribute{
 				Description: "PSP identifier",
 				Computed:    true,
@@ -565,6 +617,41 @@ func (r *pspResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"custom_domain_dns_records": schema.ListNestedAttribute{
+				MarkdownDescription: "DNS records to create at your DNS provider so `custom_domain` resolves to the status page. " +
+					"Null when no custom domain is set.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Record name (the custom domain).",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Record type, for example CNAME.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "Record value.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"custom_domain_status": schema.StringAttribute{
+				MarkdownDescription: "Verification status of `custom_domain` as reported by the API, for example `pending` or `verified`. " +
+					"Null when no custom domain is set or the API does not report a status.",
+				Computed: true,
+			},
+			"wait_for_custom_domain_verification": schema.StringAttribute{
+				MarkdownDescription: "Optional duration such as `15m`. When set together with `custom_domain`, create and update wait until " +
+					"the API reports the custom domain as verified, and fail if it is not verified within this time. Fails at once when " +
+					"the API does not report a verification status for the domain.",
+				Optional: true,
+				Validators: []validator.String{
+					pspDurationValidator{},
+				},
+			},
 			"password": schema.StringAttribute{
 				Description: "Password for the PSP",
 				MarkdownDescription: `Password for accessing the PSP page.
@@ -578,6 +665,26 @@ func (r *pspResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				Description: "Write-only password for the PSP",
+				MarkdownDescription: `Write-only password for accessing the PSP page.
+- Never stored in state. Requires Terraform 1.11 or later.
+- Sent on create and whenever 'password_wo_version' changes.`,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "Version of password_wo. Change it to send a new password_wo to the API.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
 			"is_password_set": schema.BoolAttribute{
 				Description: "Whether a password is set for the PSP",
 				Computed:    true,
@@ -914,9 +1021,39 @@ func (r *pspResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			optimeout.BlockName: optimeout.Block(),
+		},
 	}
 }
 
+// ModifyPlan keeps custom domain outputs from state while the custom domain is unchanged.
+func (r *pspResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state pspResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CustomDomain.IsUnknown() || !plan.CustomDomain.Equal(state.CustomDomain) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("custom_domain_dns_records"), state.CustomDomainDNSRecords)...)
+
+	// A configured verification wait can move the status to verified during apply.
+	if _, waiting := pspCustomDomainVerificationTimeout(plan); waiting &&
+		normalizePSPCustomDomainStatus(state.CustomDomainStatus.ValueString()) != pspCustomDomainStatusVerified {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("custom_domain_status"), state.CustomDomainStatus)...)
+}
+
 func (r *pspResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var plan, config pspResourceModel
@@ -936,6 +1073,14 @@ func (r *pspResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	createTimeout, diags := optimeout.Create(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := createTimeout.Context(ctx)
+	defer cancel()
 	hasMonitorPlan := monitorSelection.hasPlan
 	requestedMonitorIDs := monitorSelection.monitorIDs
 
@@ -986,6 +1131,9 @@ func (r *pspResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if !plan.Password.IsNull() && !plan.Password.IsUnknown() {
 		psp.Password = plan.Password.ValueStringPointer()
 	}
+	if !config.PasswordWO.IsNull() && !config.PasswordWO.IsUnknown() {
+		psp.Password = config.PasswordWO.ValueStringPointer()
+	}
 
 	if hasMonitorPlan {
 		psp.MonitorIDs = &requestedMonitorIDs
@@ -1146,9 +1294,10 @@ func (r *pspResource) Create(ctx context.Context, req resource.CreateRequest, re
 			resp.Diagnostics.AddError("PSP access denied", msg)
 			return
 		}
-		resp.Diagnostics.AddError(
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, pspAPIFieldNames,
 			"Error creating PSP",
 			"Could not create PSP, unexpected error: "+err.Error(),
+			err,
 		)
 		return
 	}
@@ -1172,9 +1321,10 @@ func (r *pspResource) Create(ctx context.Context, req resource.CreateRequest, re
 					),
 				)
 			}
-			resp.Diagnostics.AddError(
+			apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, pspAPIFieldNames,
 				"Error updating PSP",
 				"Could not set PSP fields after create, unexpected error: "+err.Error(),
+				err,
 			)
 			return
 		}
@@ -1196,9 +1346,10 @@ func (r *pspResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if logoPath != nil || iconPath != nil {
 		pspWithFiles, err := r.client.UpdatePSPFiles(ctx, newPSP.ID, logoPath, iconPath, false, false)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			apidiag.AddRequestError(&resp.Diagnostics,
 				"Error uploading PSP files",
 				"Could not upload PSP logo/icon files, unexpected error: "+err.Error(),
+				err,
 			)
 			return
 		}
@@ -1221,7 +1372,7 @@ func (r *pspResource) Create(ctx context.Context, req resource.CreateRequest, re
 		expectedHomepageLink,
 		expectedSubscription,
 		expectedMonitorSort,
-		120*time.Second,
+		createTimeout.Wait(pspSettleTimeout),
 	); err == nil && settled != nil {
 		pspForState = settled
 	} else if err != nil {
@@ -1265,10 +1416,20 @@ func (r *pspResource) Create(ctx context.Context, req resource.CreateRequest, re
 		}
 	}
 
+	var verificationErr error
+	if timeout, ok := pspCustomDomainVerificationTimeout(plan); ok {
+		verified, err := waitPSPCustomDomainVerified(ctx, r.client, pspForState.ID, timeout)
+		if verified != nil {
+			pspForState = verified
+		}
+		verificationErr = err
+	}
+
 	// Map response body to schema and populate Computed attribute values
 	var updatedPlan = plan
 	pspToResourceData(ctx, pspForState, &updatedPlan)
 	updatedPlan.Name = plan.Name
+	preservePlannedCustomDomainValues(plan, &updatedPlan)
 
 	if hasMonitorPlan {
 		if monitorSelection.configuredMonitorIDs {
@@ -1323,6 +1484,10 @@ func (r *pspResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if verificationErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_for_custom_domain_verification"), "Custom domain not verified", verificationErr.Error())
+	}
 }
 
 func (r *pspResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -1342,6 +1507,14 @@ func (r *pspResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	readTimeout, diags := optimeout.Read(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := readTimeout.Context(ctx)
+	defer cancel()
+
 	psp, err := r.client.GetPSP(ctx, id)
 	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
@@ -1424,7 +1597,7 @@ func (r *pspResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 			expectedHomepageLink,
 			expectedSubscription,
 			expectedMonitorSort,
-			20*time.Second,
+			readTimeout.Wait(pspReadSettleTimeout),
 		); err == nil && settled != nil {
 			psp = settled
 		} else if settled != nil {
@@ -1514,6 +1687,14 @@ func (r *pspResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	updateTimeout, diags := optimeout.Update(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := updateTimeout.Context(ctx)
+	defer cancel()
 	hasMonitorPlan := monitorSelection.hasPlan
 	requestedMonitorIDs := monitorSelection.monitorIDs
 
@@ -1585,6 +1766,9 @@ func (r *pspResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		(state.Password.IsNull() || state.Password.IsUnknown() || plan.Password.ValueString() != state.Password.ValueString()) {
 		psp.Password = plan.Password.ValueStringPointer()
 	}
+	if password := pspPasswordWOForUpdate(plan, state, config); password != nil {
+		psp.Password = password
+	}
 	if !plan.GACode.IsNull() && !plan.GACode.IsUnknown() &&
 		(state.GACode.IsNull() || state.GACode.IsUnknown() || plan.GACode.ValueString() != state.GACode.ValueString()) {
 		gaCode := plan.GACode.ValueString()
@@ -1768,9 +1952,10 @@ func (r *pspResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	// Update PSP
 	updatedPSP, err := r.client.UpdatePSP(ctx, id, psp)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, pspAPIFieldNames,
 			"Error updating PSP",
 			"Could not update PSP, unexpected error: "+err.Error(),
+			err,
 		)
 		return
 	}
@@ -1778,9 +1963,10 @@ func (r *pspResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	if uploadLogoPath != nil || uploadIconPath != nil || clearLogo || clearIcon {
 		pspWithFiles, err := r.client.UpdatePSPFiles(ctx, id, uploadLogoPath, uploadIconPath, clearLogo, clearIcon)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			apidiag.AddRequestError(&resp.Diagnostics,
 				"Error uploading PSP files",
 				"Could not upload/clear PSP logo/icon files, unexpected error: "+err.Error(),
+				err,
 			)
 			return
 		}
@@ -1798,7 +1984,7 @@ func (r *pspResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		expectedHomepageLink,
 		expectedSubscription,
 		expectedMonitorSort,
-		120*time.Second,
+		updateTimeout.Wait(pspSettleTimeout),
 	); err == nil && settled != nil {
 		pspForState = settled
 	} else if err != nil {
@@ -1820,9 +2006,19 @@ func (r *pspResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		}
 	}
 
+	var verificationErr error
+	if timeout, ok := pspCustomDomainVerificationTimeout(plan); ok {
+		verified, err := waitPSPCustomDomainVerified(ctx, r.client, id, timeout)
+		if verified != nil {
+			pspForState = verified
+		}
+		verificationErr = err
+	}
+
 	var newState = plan
 	pspToResourceData(ctx, pspForState, &newState)
 	newState.Name = plan.Name
+	preservePlannedCustomDomainValues(plan, &newState)
 	if plan.Icon.IsNull() {
 		newState.Icon = state.Icon
 	}
@@ -1859,6 +2055,10 @@ func (r *pspResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+
+	if verificationErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_for_custom_domain_verification"), "Custom domain not verified", verificationErr.Error())
+	}
 }
 
 func (r *pspResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1878,17 +2078,29 @@ func (r *pspResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		)
 		return
 	}
+	if !deletionprotection.CheckDelete(state.DeletionProtection, r.protectAll, "status page", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	deleteTimeout, diags := optimeout.Delete(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := deleteTimeout.Context(ctx)
+	defer cancel()
 
 	err = r.client.DeletePSP(ctx, id)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		apidiag.AddRequestError(&resp.Diagnostics,
 			"Error deleting PSP",
 			"Could not delete PSP, unexpected error: "+err.Error(),
+			err,
 		)
 		return
 	}
 
-	err = r.client.WaitPSPDeleted(ctx, id, 2*time.Minute)
+	err = r.client.WaitPSPDeleted(ctx, id, deleteTimeout.Wait(pspDeleteTimeout))
 	if err != nil {
 		resp.Diagnostics.AddError("Timed out waiting for deletion", err.Error())
 		return // resource will be kept in state and self healed on read or via next apply
@@ -2230,6 +2442,8 @@ func pspToResourceData(ctx context.Context, psp *client.PSP, plan *pspResourceMo
 	} else {
 		plan.CustomDomain = types.StringNull()
 	}
+	plan.CustomDomainDNSRecords = pspCustomDomainDNSRecordsValue(psp)
+	plan.CustomDomainStatus = pspCustomDomainStatusValue(psp)
 
 	if psp.GACode != nil && strings.TrimSpace(*psp.GACode) != "" {
 		plan.GACode = types.StringValue(*psp.GACode)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/tfconv"
 )
 
@@ -107,6 +108,11 @@ func upgradePSPFromV0(ctx context.Context, prior pspV0Model) (pspResourceModel,
 		Subscription:               prior.Subscription,
 		ShowCookieBar:              prior.ShowCookieBar,
 		PinnedAnnouncementID:       prior.PinnedAnnouncementID,
+		CustomDomainDNSRecords:     types.ListNull(pspCustomDomainDNSRecordObjectType()),
+		CustomDomainStatus:         types.StringNull(),
+		PasswordWO:                 types.StringNull(),
+		PasswordWOVersion:          types.Int64Null(),
+		Timeouts:                   optimeout.Null(),
 	}
 
 	// monitor_ids: list -> set
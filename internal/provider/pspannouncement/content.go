@@ -0,0 +1,507 @@
+package pspannouncement
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Announcement content is rendered by the public status page from a small markdown subset:
+// paragraphs, "-"/"*" and numbered lists, **bold**, *italic*/_italic_, `code`, and
+// [text](url) links. Inline HTML is limited to pspContentAllowedTags; everything else is
+// stripped by the status page. The API stores content as written and documents none of
+// this, so the subset follows the status page's observed output and is only ever reported
+// as warnings.
+
+// pspContentAllowedTags maps inline HTML tags kept by the status page to the attributes kept on them.
+var pspContentAllowedTags = map[string][]string{
+	"a":      {"href"},
+	"b":      nil,
+	"strong": nil,
+	"i":      nil,
+	"em":     nil,
+	"u":      nil,
+	"code":   nil,
+	"br":     nil,
+}
+
+var pspContentVoidTags = map[string]bool{"br": true}
+
+// pspContentDroppedTags are removed together with everything up to their closing tag.
+var pspContentDroppedTags = map[string]bool{"script": true, "style": true}
+
+var (
+	pspContentTagPattern       = regexp.MustCompile(`^<(/?)([A-Za-z][A-Za-z0-9]*)((?:\s+[^<>]*?)?)\s*(/?)>`)
+	pspContentAttrPattern      = regexp.MustCompile(`([A-Za-z_:][-A-Za-z0-9_:.]*)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'=<>]+)))?`)
+	pspContentBulletPattern    = regexp.MustCompile(`^\s*[-*]\s+(.*)$`)
+	pspContentNumberedPattern  = regexp.MustCompile(`^\s*\d+[.)]\s+(.*)$`)
+	pspContentStrongPattern    = regexp.MustCompile(`\*\*([^\s*](?:[^*]*[^\s*])?)\*\*`)
+	pspContentEmStarPattern    = regexp.MustCompile(`\*([^\s*](?:[^*]*[^\s*])?)\*`)
+	pspContentEmUnderPattern   = regexp.MustCompile(`(^|[^\p{L}\p{N}_])_([^\s_](?:[^_]*[^\s_])?)_($|[^\p{L}\p{N}_])`)
+	pspContentPlaceholderRegex = regexp.MustCompile("\uE000(\\d+)\uE001")
+)
+
+// Rendered code spans, links and tags are swapped for private-use placeholders while emphasis
+// is applied to the surrounding text, so markers inside them are left alone.
+var pspContentPlaceholderStripper = strings.NewReplacer("\uE000", "", "\uE001", "")
+
+// pspContentIssue describes content that the status page would not render as written.
+type pspContentIssue struct {
+	Line    int
+	Message string
+}
+
+func (i pspContentIssue) String() string {
+	return fmt.Sprintf("line %d: %s", i.Line, i.Message)
+}
+
+type pspContentTokenKind int
+
+const (
+	pspContentText pspContentTokenKind = iota
+	pspContentCode
+	pspContentLink
+	pspContentTag
+	pspContentComment
+)
+
+type pspContentAttr struct {
+	Name  string
+	Value string
+}
+
+type pspContentToken struct {
+	Kind    pspContentTokenKind
+	Text    string
+	URL     string
+	Name    string
+	Closing bool
+	Attrs   []pspContentAttr
+	Issue   string
+}
+
+// validatePSPAnnouncementContent reports markdown and HTML the status page renderer does not support.
+func validatePSPAnnouncementContent(content string) []pspContentIssue {
+	var issues []pspContentIssue
+	type openTag struct {
+		name string
+		line int
+	}
+	var open []openTag
+
+	closeBlock := func() {
+		for _, tag := range open {
+			issues = append(issues, pspContentIssue{Line: tag.line, Message: fmt.Sprintf("<%s> is never closed", tag.name)})
+		}
+		open = nil
+	}
+
+	for index, line := range strings.Split(content, "\n") {
+		lineNumber := index + 1
+		if strings.TrimSpace(line) == "" {
+			closeBlock()
+			continue
+		}
+
+		var text strings.Builder
+		var visit func(tokens []pspContentToken)
+		visit = func(tokens []pspContentToken) {
+			for _, token := range tokens {
+				if token.Issue != "" {
+					issues = append(issues, pspContentIssue{Line: lineNumber, Message: token.Issue})
+				}
+				switch token.Kind {
+				case pspContentText:
+					text.WriteString(token.Text)
+				case pspContentLink:
+					visit(tokenizePSPContent(token.Text))
+				case pspContentTag:
+					if _, ok := pspContentAllowedTags[token.Name]; !ok || pspContentVoidTags[token.Name] {
+						continue
+					}
+					if !token.Closing {
+						open = append(open, openTag{name: token.Name, line: lineNumber})
+						continue
+					}
+					match := -1
+					for i := len(open) - 1; i >= 0; i-- {
+						if open[i].name == token.Name {
+							match = i
+							break
+						}
+					}
+					if match < 0 {
+						issues = append(issues, pspContentIssue{Line: lineNumber, Message: fmt.Sprintf("</%s> has no matching opening tag", token.Name)})
+						continue
+					}
+					for _, tag := range open[match+1:] {
+						issues = append(issues, pspContentIssue{Line: tag.line, Message: fmt.Sprintf("<%s> is never closed", tag.name)})
+					}
+					open = open[:match]
+				}
+			}
+		}
+		visit(tokenizePSPContent(line))
+
+		if strings.Count(text.String(), "**")%2 != 0 {
+			issues = append(issues, pspContentIssue{Line: lineNumber, Message: "unbalanced ** bold marker"})
+		}
+	}
+	closeBlock()
+
+	return issues
+}
+
+// renderPSPAnnouncementContent renders content to the sanitized HTML the status page displays.
+// Unsupported tags and attributes are stripped and unclosed tags are closed at the end of their block.
+func renderPSPAnnouncementContent(content string) string {
+	var out []string
+	var paragraph []string
+	var listTag string
+	var items []string
+
+	flushParagraph := func() {
+		if len(paragraph) > 0 {
+			out = append(out, "<p>"+renderPSPContentBlock(strings.Join(paragraph, "\n"))+"</p>")
+			paragraph = nil
+		}
+	}
+	flushList := func() {
+		if len(items) > 0 {
+			var b strings.Builder
+			b.WriteString("<" + listTag + ">")
+			for _, item := range items {
+				b.WriteString("<li>" + renderPSPContentBlock(item) + "</li>")
+			}
+			b.WriteString("</" + listTag + ">")
+			out = append(out, b.String())
+			items = nil
+		}
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		switch {
+		case strings.TrimSpace(line) == "":
+			flushParagraph()
+			flushList()
+		case pspContentBulletPattern.MatchString(line):
+			flushParagraph()
+			if listTag != "ul" {
+				flushList()
+			}
+			listTag = "ul"
+			items = append(items, pspContentBulletPattern.FindStringSubmatch(line)[1])
+		case pspContentNumberedPattern.MatchString(line):
+			flushParagraph()
+			if listTag != "ol" {
+				flushList()
+			}
+			listTag = "ol"
+			items = append(items, pspContentNumberedPattern.FindStringSubmatch(line)[1])
+		default:
+			flushList()
+			paragraph = append(paragraph, strings.TrimSpace(line))
+		}
+	}
+	flushParagraph()
+	flushList()
+
+	return strings.Join(out, "\n")
+}
+
+// renderPSPContentBlock renders the inline content of one paragraph or list item.
+func renderPSPContentBlock(block string) string {
+	var open []string
+	var b strings.Builder
+	for i, line := range strings.Split(block, "\n") {
+		if i > 0 {
+			b.WriteString("<br>")
+		}
+		b.WriteString(renderPSPContentInline(tokenizePSPContent(line), &open))
+	}
+	for i := len(open) - 1; i >= 0; i-- {
+		b.WriteString("</" + open[i] + ">")
+	}
+	return b.String()
+}
+
+func renderPSPContentInline(tokens []pspContentToken, open *[]string) string {
+	var placeholders []string
+	var b strings.Builder
+	placeholder := func(rendered string) {
+		b.WriteString("\uE000" + strconv.Itoa(len(placeholders)) + "\uE001")
+		placeholders = append(placeholders, rendered)
+	}
+
+	dropping := ""
+	for _, token := range tokens {
+		if dropping != "" {
+			if token.Kind == pspContentTag && token.Closing && token.Name == dropping {
+				dropping = ""
+			}
+			continue
+		}
+
+		switch token.Kind {
+		case pspContentText:
+			b.WriteString(html.EscapeString(pspContentPlaceholderStripper.Replace(token.Text)))
+		case pspContentCode:
+			placeholder("<code>" + html.EscapeString(token.Text) + "</code>")
+		case pspContentLink:
+			var linkOpen []string
+			label := renderPSPContentInline(tokenizePSPContent(token.Text), &linkOpen)
+			for i := len(linkOpen) - 1; i >= 0; i-- {
+				label += "</" + linkOpen[i] + ">"
+			}
+			if token.Issue != "" {
+				placeholder(label)
+				continue
+			}
+			placeholder(`<a href="` + html.EscapeString(token.URL) + `">` + label + "</a>")
+		case pspContentTag:
+			placeholder(renderPSPContentTag(token, open, &dropping))
+		case pspContentComment:
+			// Stripped by the status page.
+		}
+	}
+
+	rendered := b.String()
+	rendered = pspContentStrongPattern.ReplaceAllString(rendered, "<strong>$1</strong>")
+	rendered = pspContentEmStarPattern.ReplaceAllString(rendered, "<em>$1</em>")
+	rendered = pspContentEmUnderPattern.ReplaceAllString(rendered, "$1<em>$2</em>$3")
+	return pspContentPlaceholderRegex.ReplaceAllStringFunc(rendered, func(match string) string {
+		index, _ := strconv.Atoi(pspContentPlaceholderRegex.FindStringSubmatch(match)[1])
+		return placeholders[index]
+	})
+}
+
+func renderPSPContentTag(token pspContentToken, open *[]string, dropping *string) string {
+	allowedAttrs, ok := pspContentAllowedTags[token.Name]
+	if !ok {
+		if pspContentDroppedTags[token.Name] && !token.Closing {
+			*dropping = token.Name
+		}
+		return ""
+	}
+
+	if pspContentVoidTags[token.Name] {
+		if token.Closing {
+			return ""
+		}
+		return "<" + token.Name + ">"
+	}
+
+	if token.Closing {
+		for i := len(*open) - 1; i >= 0; i-- {
+			if (*open)[i] != token.Name {
+				continue
+			}
+			var b strings.Builder
+			for j := len(*open) - 1; j >= i; j-- {
+				b.WriteString("</" + (*open)[j] + ">")
+			}
+			*open = (*open)[:i]
+			return b.String()
+		}
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("<" + token.Name)
+	for _, attr := range token.Attrs {
+		if !slices.Contains(allowedAttrs, attr.Name) {
+			continue
+		}
+		if attr.Name == "href" && validatePSPContentURL(attr.Value) != "" {
+			continue
+		}
+		b.WriteString(" " + attr.Name + `="` + html.EscapeString(attr.Value) + `"`)
+	}
+	b.WriteString(">")
+	*open = append(*open, token.Name)
+	return b.String()
+}
+
+// tokenizePSPContent splits a single line into text, code spans, markdown links, HTML tags and comments.
+func tokenizePSPContent(line string) []pspContentToken {
+	var tokens []pspContentToken
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			tokens = append(tokens, pspContentToken{Kind: pspContentText, Text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for i := 0; i < len(line); {
+		rest := line[i:]
+		switch rest[0] {
+		case '`':
+			end := strings.IndexByte(rest[1:], '`')
+			if end < 0 {
+				flush()
+				tokens = append(tokens, pspContentToken{Kind: pspContentText, Text: rest, Issue: "inline code span is missing its closing backtick"})
+				return tokens
+			}
+			flush()
+			tokens = append(tokens, pspContentToken{Kind: pspContentCode, Text: rest[1 : end+1]})
+			i += end + 2
+			continue
+		case '[':
+			if token, size, ok := parsePSPContentLink(rest); ok {
+				flush()
+				tokens = append(tokens, token)
+				i += size
+				continue
+			}
+		case '<':
+			if strings.HasPrefix(rest, "<!--") {
+				flush()
+				end := strings.Index(rest, "-->")
+				if end < 0 {
+					tokens = append(tokens, pspContentToken{Kind: pspContentComment, Issue: "HTML comment is never closed"})
+					return tokens
+				}
+				tokens = append(tokens, pspContentToken{Kind: pspContentComment, Issue: "HTML comments are stripped by the status page"})
+				i += end + 3
+				continue
+			}
+			if match := pspContentTagPattern.FindStringSubmatch(rest); match != nil {
+				flush()
+				tokens = append(tokens, newPSPContentTagToken(match))
+				i += len(match[0])
+				continue
+			}
+		}
+		text.WriteByte(rest[0])
+		i++
+	}
+	flush()
+
+	return tokens
+}
+
+// parsePSPContentLink parses a markdown link at the start of s. It returns ok=false when s
+// does not start a link at all, so the bracket is kept as text.
+func parsePSPContentLink(s string) (pspContentToken, int, bool) {
+	depth := 0
+	closeBracket := -1
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		}
+		if depth == 0 {
+			closeBracket = i
+			break
+		}
+	}
+	if closeBracket < 0 || closeBracket+1 >= len(s) || s[closeBracket+1] != '(' {
+		return pspContentToken{}, 0, false
+	}
+
+	label := s[1:closeBracket]
+	rest := s[closeBracket+2:]
+	closeParen := strings.IndexByte(rest, ')')
+	if closeParen < 0 {
+		return pspContentToken{
+			Kind:  pspContentLink,
+			Text:  label,
+			Issue: fmt.Sprintf("markdown link [%s] is missing its closing parenthesis", label),
+		}, len(s), true
+	}
+
+	rawURL := strings.TrimSpace(rest[:closeParen])
+	token := pspContentToken{Kind: pspContentLink, Text: label, URL: rawURL}
+	switch {
+	case strings.TrimSpace(label) == "":
+		token.Issue = "markdown link has empty text"
+	case rawURL == "":
+		token.Issue = fmt.Sprintf("markdown link [%s] has an empty URL", label)
+	default:
+		if problem := validatePSPContentURL(rawURL); problem != "" {
+			token.Issue = fmt.Sprintf("markdown link [%s] %s", label, problem)
+		}
+	}
+	return token, closeBracket + 2 + closeParen + 1, true
+}
+
+func newPSPContentTagToken(match []string) pspContentToken {
+	token := pspContentToken{
+		Kind:    pspContentTag,
+		Name:    strings.ToLower(match[2]),
+		Closing: match[1] == "/",
+	}
+	for _, attr := range pspContentAttrPattern.FindAllStringSubmatch(match[3], -1) {
+		token.Attrs = append(token.Attrs, pspContentAttr{
+			Name:  strings.ToLower(attr[1]),
+			Value: attr[2] + attr[3] + attr[4],
+		})
+	}
+
+	allowedAttrs, allowed := pspContentAllowedTags[token.Name]
+	switch {
+	case !allowed:
+		if !token.Closing {
+			token.Issue = fmt.Sprintf("HTML tag <%s> is not supported and is stripped by the status page", token.Name)
+		}
+	case token.Closing:
+	default:
+		for _, attr := range token.Attrs {
+			if !slices.Contains(allowedAttrs, attr.Name) {
+				token.Issue = fmt.Sprintf("attribute %q on <%s> is stripped by the status page", attr.Name, token.Name)
+				break
+			}
+			if attr.Name == "href" {
+				if problem := validatePSPContentURL(attr.Value); problem != "" {
+					token.Issue = fmt.Sprintf("<a> href %s", problem)
+					break
+				}
+			}
+		}
+		if token.Issue == "" && token.Name == "a" && !containsPSPContentAttrName(token.Attrs, "href") {
+			token.Issue = "<a> is missing an href attribute"
+		}
+	}
+	return token
+}
+
+// validatePSPContentURL returns a description of why rawURL cannot be used as a link target, or "".
+func validatePSPContentURL(rawURL string) string {
+	if strings.ContainsAny(rawURL, " \t") {
+		return fmt.Sprintf("URL %q contains whitespace", rawURL)
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Sprintf("URL %q is invalid", rawURL)
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+		if parsed.Host == "" {
+			return fmt.Sprintf("URL %q has no host", rawURL)
+		}
+		return ""
+	case "mailto":
+		if parsed.Opaque == "" {
+			return fmt.Sprintf("URL %q has no address", rawURL)
+		}
+		return ""
+	default:
+		return fmt.Sprintf("URL %q must be an absolute http, https, or mailto URL", rawURL)
+	}
+}
+
+func containsPSPContentAttrName(attrs []pspContentAttr, name string) bool {
+	for _, attr := range attrs {
+		if attr.Name == name {
+			return true
+		}
+	}
+	return false
+}
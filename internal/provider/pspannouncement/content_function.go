@@ -0,0 +1,45 @@
+package pspannouncement
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &contentHTMLFunction{}
+
+// NewContentHTMLFunction returns the function that renders announcement content to sanitized HTML.
+func NewContentHTMLFunction() function.Function {
+	return &contentHTMLFunction{}
+}
+
+type contentHTMLFunction struct{}
+
+func (f *contentHTMLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "psp_announcement_html"
+}
+
+func (f *contentHTMLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render PSP announcement content to HTML",
+		MarkdownDescription: "Renders `uptimerobot_psp_announcement` content to the sanitized HTML shown on the public status page. " +
+			"Unsupported HTML tags and attributes are stripped, and unclosed tags are closed at the end of their paragraph or list item.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "content",
+				MarkdownDescription: "Announcement content using the markdown subset supported by the public status page.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *contentHTMLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &content))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, renderPSPAnnouncementContent(content)))
+}
@@ -0,0 +1,213 @@
+package pspannouncement
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidatePSPAnnouncementContent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name: "supported markdown and html",
+			content: "We will **upgrade** the _database_ cluster.\n\n" +
+				"- See [status](https://status.example.com) or <a href=\"mailto:ops@example.com\">email us</a>\n" +
+				"- Run `SELECT <b>` if needed<br>\n" +
+				"1. <strong>Done</strong>",
+		},
+		{
+			name:    "unclosed markdown link",
+			content: "Intro\nSee [docs](https://example.com/docs for details",
+			want:    []string{"line 2: markdown link [docs] is missing its closing parenthesis"},
+		},
+		{
+			name:    "relative link",
+			content: "See [docs](/docs).",
+			want:    []string{`line 1: markdown link [docs] URL "/docs" must be an absolute http, https, or mailto URL`},
+		},
+		{
+			name:    "javascript link",
+			content: "<a href=\"javascript:alert(1)\">x</a>",
+			want:    []string{`line 1: <a> href URL "javascript:alert(1)" must be an absolute http, https, or mailto URL`},
+		},
+		{
+			name:    "empty link url",
+			content: "See [docs]().",
+			want:    []string{"line 1: markdown link [docs] has an empty URL"},
+		},
+		{
+			name:    "unsupported tag",
+			content: "Intro\n\n<img src=\"https://example.com/x.png\">",
+			want:    []string{"line 3: HTML tag <img> is not supported and is stripped by the status page"},
+		},
+		{
+			name:    "stripped attribute",
+			content: "<b style=\"color:red\">Heads up</b>",
+			want:    []string{`line 1: attribute "style" on <b> is stripped by the status page`},
+		},
+		{
+			name:    "unclosed tag reported at its line",
+			content: "<b>Heads up\nstill bold\n\nnext paragraph",
+			want:    []string{"line 1: <b> is never closed"},
+		},
+		{
+			name:    "stray closing tag",
+			content: "Done</em>",
+			want:    []string{"line 1: </em> has no matching opening tag"},
+		},
+		{
+			name:    "unbalanced bold",
+			content: "This is **important",
+			want:    []string{"line 1: unbalanced ** bold marker"},
+		},
+		{
+			name:    "unclosed code span",
+			content: "Run `make deploy",
+			want:    []string{"line 1: inline code span is missing its closing backtick"},
+		},
+		{
+			name:    "html comment",
+			content: "Visible <!-- hidden -->",
+			want:    []string{"line 1: HTML comments are stripped by the status page"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, issue := range validatePSPAnnouncementContent(tt.content) {
+				got = append(got, issue.String())
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Fatalf("expected issues:\n%s\ngot:\n%s", strings.Join(tt.want, "\n"), strings.Join(got, "\n"))
+			}
+		})
+	}
+}
+
+func TestRenderPSPAnnouncementContent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "paragraphs and line breaks",
+			content: "First line\nsecond line\n\nNext paragraph",
+			want:    "<p>First line<br>second line</p>\n<p>Next paragraph</p>",
+		},
+		{
+			name:    "emphasis and code",
+			content: "**Bold**, *italic*, _also italic_, and `a **literal** <b>`",
+			want:    "<p><strong>Bold</strong>, <em>italic</em>, <em>also italic</em>, and <code>a **literal** &lt;b&gt;</code></p>",
+		},
+		{
+			name:    "snake case is not emphasis",
+			content: "Restart worker_pool_size",
+			want:    "<p>Restart worker_pool_size</p>",
+		},
+		{
+			name:    "links",
+			content: "See [**status**](https://status.example.com?a=1&b=2)",
+			want:    `<p>See <a href="https://status.example.com?a=1&amp;b=2"><strong>status</strong></a></p>`,
+		},
+		{
+			name:    "lists",
+			content: "Affected:\n- API\n- Dashboard\n1. Drain\n2. Upgrade",
+			want:    "<p>Affected:</p>\n<ul><li>API</li><li>Dashboard</li></ul>\n<ol><li>Drain</li><li>Upgrade</li></ol>",
+		},
+		{
+			name:    "sanitizes html",
+			content: `<b onclick="x()">Hi</b> <img src=x> <script>alert(1)</script><a href="javascript:x" title="t">link</a> 1 < 2`,
+			want:    `<p><b>Hi</b>  <a>link</a> 1 &lt; 2</p>`,
+		},
+		{
+			name:    "closes unclosed tags per block",
+			content: "<em>open\n\nafter",
+			want:    "<p><em>open</em></p>\n<p>after</p>",
+		},
+		{
+			name:    "broken link keeps its text",
+			content: "[docs](/docs)",
+			want:    "<p>docs</p>",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := renderPSPAnnouncementContent(tt.content); got != tt.want {
+				t.Fatalf("expected:\n%s\ngot:\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPSPAnnouncementContentValidator(t *testing.T) {
+	t.Parallel()
+
+	resp := &validator.StringResponse{}
+	pspAnnouncementContentValidator{}.ValidateString(
+		context.Background(),
+		validator.StringRequest{
+			Path:        path.Root("content"),
+			ConfigValue: types.StringValue("ok\n<iframe src=\"https://example.com\"></iframe>"),
+		},
+		resp,
+	)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("expected one warning and no error, got %#v", resp.Diagnostics)
+	}
+	if detail := resp.Diagnostics[0].Detail(); !strings.HasPrefix(detail, "line 2: HTML tag <iframe>") {
+		t.Fatalf("unexpected diagnostic detail: %q", detail)
+	}
+
+	resp = &validator.StringResponse{}
+	pspAnnouncementContentValidator{}.ValidateString(
+		context.Background(),
+		validator.StringRequest{Path: path.Root("content"), ConfigValue: types.StringUnknown()},
+		resp,
+	)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected unknown content to be skipped, got %#v", resp.Diagnostics)
+	}
+}
+
+func TestContentHTMLFunctionRun(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewContentHTMLFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("**Heads up**")}),
+	}, resp)
+	if resp.Error != nil {
+		t.Fatalf("Run returned error: %v", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(types.String)
+	if !ok {
+		t.Fatalf("expected string result, got %T", resp.Result.Value())
+	}
+	if got.ValueString() != "<p><strong>Heads up</strong></p>" {
+		t.Fatalf("unexpected result: %q", got.ValueString())
+	}
+}
@@ -0,0 +1,39 @@
+package pspannouncement
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// pspAnnouncementContentValidator warns about markdown and HTML the status page renderer does not
+// support. The subset is what the public status page was observed to render; the API does not
+// document or enforce it, so findings are warnings and never fail a plan.
+type pspAnnouncementContentValidator struct{}
+
+func (pspAnnouncementContentValidator) Description(context.Context) string {
+	return "content should only use markdown and HTML the public status page renders"
+}
+
+func (pspAnnouncementContentValidator) MarkdownDescription(context.Context) string {
+	return "content should only use markdown and inline HTML (`a`, `b`, `strong`, `i`, `em`, `u`, `code`, `br`) the public status page renders"
+}
+
+func (pspAnnouncementContentValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, issue := range validatePSPAnnouncementContent(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Unsupported announcement content",
+			issue.String()+". The content is sent as written, but the public status page may not render it that way. "+
+				"Use the uptimerobot::psp_announcement_html function to preview how the status page renders this content.",
+		)
+	}
+}
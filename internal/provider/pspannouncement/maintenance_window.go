@@ -0,0 +1,275 @@
+package pspannouncement
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	_ "time/tzdata" // time_zone must resolve on hosts without a zoneinfo database.
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+// pspAnnouncementWindowSearchDays bounds the search for the next occurrence of a
+// recurring maintenance window. A monthly window on day 31 still recurs within a year.
+const pspAnnouncementWindowSearchDays = 400
+
+// pspAnnouncementWindowSchedule is the announcement schedule derived from a maintenance window occurrence.
+type pspAnnouncementWindowSchedule struct {
+	StartDate string
+	EndDate   string
+	Status    string
+}
+
+// pspAnnouncementTimeZone loads the IANA time zone name.
+func pspAnnouncementTimeZone(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("expected an IANA time zone such as \"Europe/Berlin\", got %q", name)
+	}
+	return loc, nil
+}
+
+// pspAnnouncementWindowLocation returns the time zone maintenance windows are read in:
+// time_zone when it is set, otherwise the time zone of the account. There is no default,
+// since reading a window in any other zone than the account's misplaces the announcement.
+func pspAnnouncementWindowLocation(ctx context.Context, account *providerclient.Account, v types.String) (*time.Location, error) {
+	if !v.IsNull() && !v.IsUnknown() {
+		return pspAnnouncementTimeZone(v.ValueString())
+	}
+	if account == nil {
+		return nil, fmt.Errorf("the account time zone is not available; set time_zone to the time zone of the UptimeRobot account")
+	}
+	user, err := account.User(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the account time zone, set time_zone to the time zone of the UptimeRobot account: %w", err)
+	}
+	if user.TimeZone == "" {
+		return nil, fmt.Errorf("the API did not report the account time zone; set time_zone to the time zone of the UptimeRobot account")
+	}
+	loc, err := pspAnnouncementTimeZone(user.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("the account time zone is not usable, set time_zone: %w", err)
+	}
+	return loc, nil
+}
+
+// pspAnnouncementWindowLinked reports whether the announcement derives its schedule from a maintenance window.
+func pspAnnouncementWindowLinked(m pspAnnouncementResourceModel) bool {
+	return !m.MaintenanceWindowID.IsNull()
+}
+
+// resolvePSPAnnouncementWindowSchedule returns the schedule for an announcement linked to windowID.
+//
+// An announcement keeps the occurrence it was anchored to once that occurrence has started,
+// so later applies only move its status forward (pending -> published -> archived).
+// Before that, the schedule follows the window's next occurrence so edits to the window are picked up.
+// Once the anchored occurrence has ended, a recurring window moves the announcement on to its
+// next occurrence; a window without one leaves it archived.
+func resolvePSPAnnouncementWindowSchedule(
+	ctx context.Context,
+	c *client.Client,
+	windowID int64,
+	loc *time.Location,
+	prior *pspAnnouncementResourceModel,
+	now time.Time,
+) (pspAnnouncementWindowSchedule, error) {
+	anchor, anchored := anchoredPSPAnnouncementWindowSchedule(windowID, prior, now)
+	if anchored && !pspAnnouncementWindowScheduleEnded(anchor, now) {
+		return anchor, nil
+	}
+
+	window, err := c.GetMaintenanceWindow(ctx, windowID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			if anchored {
+				return anchor, nil
+			}
+			return pspAnnouncementWindowSchedule{}, fmt.Errorf("maintenance window %d was not found", windowID)
+		}
+		return pspAnnouncementWindowSchedule{}, fmt.Errorf("error reading maintenance window %d: %w", windowID, err)
+	}
+
+	start, end, err := nextMaintenanceWindowOccurrence(window, loc, now)
+	if err != nil {
+		if anchored {
+			return anchor, nil
+		}
+		return pspAnnouncementWindowSchedule{}, err
+	}
+
+	return pspAnnouncementWindowSchedule{
+		StartDate: start.Format(time.RFC3339),
+		EndDate:   end.Format(time.RFC3339),
+		Status:    pspAnnouncementWindowStatus(start, end, now),
+	}, nil
+}
+
+// anchoredPSPAnnouncementWindowSchedule returns the schedule stored in prior state when the
+// announcement is already tied to an occurrence of windowID that has started.
+func anchoredPSPAnnouncementWindowSchedule(
+	windowID int64,
+	prior *pspAnnouncementResourceModel,
+	now time.Time,
+) (pspAnnouncementWindowSchedule, bool) {
+	if prior == nil || prior.MaintenanceWindowID.IsNull() || prior.MaintenanceWindowID.IsUnknown() {
+		return pspAnnouncementWindowSchedule{}, false
+	}
+	if prior.MaintenanceWindowID.ValueInt64() != windowID {
+		return pspAnnouncementWindowSchedule{}, false
+	}
+	if !pspAnnouncementKnownString(prior.StartDate) || !pspAnnouncementKnownString(prior.EndDate) {
+		return pspAnnouncementWindowSchedule{}, false
+	}
+
+	start, err := time.Parse(time.RFC3339, prior.StartDate.ValueString())
+	if err != nil {
+		return pspAnnouncementWindowSchedule{}, false
+	}
+	end, err := time.Parse(time.RFC3339, prior.EndDate.ValueString())
+	if err != nil {
+		return pspAnnouncementWindowSchedule{}, false
+	}
+
+	priorStatus := normalizePSPAnnouncementStatus(prior.Status.ValueString())
+	if priorStatus == "pending" && now.Before(start) {
+		return pspAnnouncementWindowSchedule{}, false
+	}
+
+	status := pspAnnouncementWindowStatus(start, end, now)
+	if pspAnnouncementWindowStatusRank(priorStatus) > pspAnnouncementWindowStatusRank(status) {
+		status = priorStatus
+	}
+
+	return pspAnnouncementWindowSchedule{
+		StartDate: start.UTC().Format(time.RFC3339),
+		EndDate:   end.UTC().Format(time.RFC3339),
+		Status:    status,
+	}, true
+}
+
+// pspAnnouncementWindowScheduleEnded reports whether the occurrence of schedule is over at now.
+func pspAnnouncementWindowScheduleEnded(schedule pspAnnouncementWindowSchedule, now time.Time) bool {
+	end, err := time.Parse(time.RFC3339, schedule.EndDate)
+	return err == nil && !now.Before(end)
+}
+
+// pspAnnouncementWindowStatus maps the position of now relative to an occurrence to an announcement status.
+func pspAnnouncementWindowStatus(start, end, now time.Time) string {
+	switch {
+	case now.Before(start):
+		return "pending"
+	case now.Before(end):
+		return "published"
+	default:
+		return "archived"
+	}
+}
+
+func pspAnnouncementWindowStatusRank(status string) int {
+	switch status {
+	case "published":
+		return 1
+	case "archived":
+		return 2
+	default:
+		return 0
+	}
+}
+
+// nextMaintenanceWindowOccurrence returns the occurrence of window that is in progress at now,
+// or the next one to start, in UTC. Window dates and times are interpreted in loc, the account
+// time zone the API reads them in.
+func nextMaintenanceWindowOccurrence(window *client.MaintenanceWindow, loc *time.Location, now time.Time) (time.Time, time.Time, error) {
+	if window == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("maintenance window is nil")
+	}
+	if window.Duration <= 0 {
+		return time.Time{}, time.Time{}, fmt.Errorf("maintenance window %d has no duration", window.ID)
+	}
+
+	clock, err := parseMaintenanceWindowClock(window.Time)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("maintenance window %d has invalid time %q: %w", window.ID, window.Time, err)
+	}
+	duration := time.Duration(window.Duration) * time.Minute
+	now = now.In(loc)
+
+	if strings.EqualFold(window.Interval, "once") {
+		if window.Date == nil || strings.TrimSpace(*window.Date) == "" {
+			return time.Time{}, time.Time{}, fmt.Errorf("maintenance window %d has interval once but no date", window.ID)
+		}
+		day, err := time.ParseInLocation(time.DateOnly, strings.TrimSpace(*window.Date), loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("maintenance window %d has invalid date %q: %w", window.ID, *window.Date, err)
+		}
+		start := maintenanceWindowStart(day, clock)
+		end := start.Add(duration)
+		if !end.After(now) {
+			return time.Time{}, time.Time{}, fmt.Errorf("maintenance window %d has no upcoming occurrence; it ended at %s", window.ID, end.UTC().Format(time.RFC3339))
+		}
+		return start.UTC(), end.UTC(), nil
+	}
+
+	// Start far enough back to find an occurrence that began earlier and is still in progress.
+	lookback := int(duration/(24*time.Hour)) + 1
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	for offset := -lookback; offset <= pspAnnouncementWindowSearchDays; offset++ {
+		day := today.AddDate(0, 0, offset)
+		if !maintenanceWindowRunsOn(window, day) {
+			continue
+		}
+		start := maintenanceWindowStart(day, clock)
+		end := start.Add(duration)
+		if end.After(now) {
+			return start.UTC(), end.UTC(), nil
+		}
+	}
+
+	return time.Time{}, time.Time{}, fmt.Errorf("maintenance window %d has no upcoming occurrence", window.ID)
+}
+
+// maintenanceWindowStart returns the wall clock time clock on day, in day's location, so
+// an occurrence on a daylight saving change still starts at the configured time.
+func maintenanceWindowStart(day time.Time, clock time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, int(clock), day.Location())
+}
+
+func maintenanceWindowRunsOn(window *client.MaintenanceWindow, day time.Time) bool {
+	switch strings.ToLower(window.Interval) {
+	case "daily":
+		return true
+	case "weekly":
+		// The API numbers weekdays 1=Mon..7=Sun.
+		weekday := int64((int(day.Weekday())+6)%7 + 1)
+		return slices.Contains(window.Days, weekday)
+	case "monthly":
+		if slices.Contains(window.Days, int64(day.Day())) {
+			return true
+		}
+		lastDay := day.AddDate(0, 1, -day.Day()).Day()
+		return day.Day() == lastDay && slices.Contains(window.Days, -1)
+	default:
+		return false
+	}
+}
+
+func parseMaintenanceWindowClock(value string) (time.Duration, error) {
+	trimmed := strings.TrimSpace(value)
+	for _, layout := range []string{time.TimeOnly, "15:04"} {
+		parsed, err := time.Parse(layout, trimmed)
+		if err == nil {
+			return time.Duration(parsed.Hour())*time.Hour +
+				time.Duration(parsed.Minute())*time.Minute +
+				time.Duration(parsed.Second())*time.Second, nil
+		}
+	}
+	return 0, fmt.Errorf("expected HH:mm:ss")
+}
+
+func pspAnnouncementKnownString(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
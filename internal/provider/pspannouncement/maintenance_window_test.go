@@ -0,0 +1,333 @@
+package pspannouncement
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+func TestNextMaintenanceWindowOccurrence(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday.
+	now := time.Date(2030, 1, 16, 12, 0, 0, 0, time.UTC)
+	date := func(value string) *string { return &value }
+
+	tests := []struct {
+		name      string
+		window    client.MaintenanceWindow
+		wantStart string
+		wantEnd   string
+		wantErr   string
+	}{
+		{
+			name:      "once in the future",
+			window:    client.MaintenanceWindow{ID: 1, Interval: "once", Date: date("2030-02-01"), Time: "22:00:00", Duration: 90},
+			wantStart: "2030-02-01T22:00:00Z",
+			wantEnd:   "2030-02-01T23:30:00Z",
+		},
+		{
+			name:      "once in progress",
+			window:    client.MaintenanceWindow{ID: 1, Interval: "once", Date: date("2030-01-16"), Time: "11:00:00", Duration: 120},
+			wantStart: "2030-01-16T11:00:00Z",
+			wantEnd:   "2030-01-16T13:00:00Z",
+		},
+		{
+			name:    "once already ended",
+			window:  client.MaintenanceWindow{ID: 1, Interval: "once", Date: date("2030-01-15"), Time: "11:00:00", Duration: 60},
+			wantErr: "no upcoming occurrence",
+		},
+		{
+			name:      "daily later today",
+			window:    client.MaintenanceWindow{ID: 2, Interval: "daily", Time: "23:00:00", Duration: 30},
+			wantStart: "2030-01-16T23:00:00Z",
+			wantEnd:   "2030-01-16T23:30:00Z",
+		},
+		{
+			name:      "daily already passed today",
+			window:    client.MaintenanceWindow{ID: 2, Interval: "daily", Time: "02:00:00", Duration: 30},
+			wantStart: "2030-01-17T02:00:00Z",
+			wantEnd:   "2030-01-17T02:30:00Z",
+		},
+		{
+			name:      "daily started yesterday and still running",
+			window:    client.MaintenanceWindow{ID: 2, Interval: "daily", Time: "22:00:00", Duration: 900},
+			wantStart: "2030-01-15T22:00:00Z",
+			wantEnd:   "2030-01-16T13:00:00Z",
+		},
+		{
+			name:      "weekly on monday",
+			window:    client.MaintenanceWindow{ID: 3, Interval: "weekly", Time: "01:00:00", Duration: 60, Days: []int64{1}},
+			wantStart: "2030-01-21T01:00:00Z",
+			wantEnd:   "2030-01-21T02:00:00Z",
+		},
+		{
+			name:      "weekly on sunday",
+			window:    client.MaintenanceWindow{ID: 3, Interval: "weekly", Time: "01:00:00", Duration: 60, Days: []int64{7}},
+			wantStart: "2030-01-20T01:00:00Z",
+			wantEnd:   "2030-01-20T02:00:00Z",
+		},
+		{
+			name:      "monthly on a day of month",
+			window:    client.MaintenanceWindow{ID: 4, Interval: "monthly", Time: "03:00:00", Duration: 60, Days: []int64{10}},
+			wantStart: "2030-02-10T03:00:00Z",
+			wantEnd:   "2030-02-10T04:00:00Z",
+		},
+		{
+			name:      "monthly on the last day",
+			window:    client.MaintenanceWindow{ID: 4, Interval: "monthly", Time: "03:00:00", Duration: 60, Days: []int64{-1}},
+			wantStart: "2030-01-31T03:00:00Z",
+			wantEnd:   "2030-01-31T04:00:00Z",
+		},
+		{
+			name:    "weekly without days",
+			window:  client.MaintenanceWindow{ID: 5, Interval: "weekly", Time: "03:00:00", Duration: 60},
+			wantErr: "no upcoming occurrence",
+		},
+		{
+			name:    "invalid time",
+			window:  client.MaintenanceWindow{ID: 6, Interval: "daily", Time: "25:00", Duration: 60},
+			wantErr: "invalid time",
+		},
+		{
+			name:    "missing duration",
+			window:  client.MaintenanceWindow{ID: 7, Interval: "daily", Time: "03:00:00"},
+			wantErr: "no duration",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			start, end, err := nextMaintenanceWindowOccurrence(&tt.window, time.UTC, now)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("nextMaintenanceWindowOccurrence returned error: %v", err)
+			}
+			if got := start.Format(time.RFC3339); got != tt.wantStart {
+				t.Fatalf("expected start %s, got %s", tt.wantStart, got)
+			}
+			if got := end.Format(time.RFC3339); got != tt.wantEnd {
+				t.Fatalf("expected end %s, got %s", tt.wantEnd, got)
+			}
+		})
+	}
+}
+
+func TestNextMaintenanceWindowOccurrenceInAccountTimeZone(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	date := func(value string) *string { return &value }
+
+	tests := []struct {
+		name      string
+		window    client.MaintenanceWindow
+		now       time.Time
+		wantStart string
+		wantEnd   string
+	}{
+		{
+			name:      "once in winter time",
+			window:    client.MaintenanceWindow{ID: 1, Interval: "once", Date: date("2030-02-01"), Time: "22:00:00", Duration: 90},
+			now:       time.Date(2030, 1, 16, 12, 0, 0, 0, time.UTC),
+			wantStart: "2030-02-01T21:00:00Z",
+			wantEnd:   "2030-02-01T22:30:00Z",
+		},
+		{
+			// 00:30 in Berlin on Thursday is still Wednesday in UTC.
+			name:      "weekly across midnight UTC",
+			window:    client.MaintenanceWindow{ID: 2, Interval: "weekly", Days: []int64{4}, Time: "00:30:00", Duration: 60},
+			now:       time.Date(2030, 1, 16, 12, 0, 0, 0, time.UTC),
+			wantStart: "2030-01-16T23:30:00Z",
+			wantEnd:   "2030-01-17T00:30:00Z",
+		},
+		{
+			// Daylight saving time starts on 2030-03-31 in Berlin.
+			name:      "daily on the daylight saving change",
+			window:    client.MaintenanceWindow{ID: 3, Interval: "daily", Time: "03:00:00", Duration: 30},
+			now:       time.Date(2030, 3, 30, 12, 0, 0, 0, time.UTC),
+			wantStart: "2030-03-31T01:00:00Z",
+			wantEnd:   "2030-03-31T01:30:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			start, end, err := nextMaintenanceWindowOccurrence(&tt.window, berlin, tt.now)
+			if err != nil {
+				t.Fatalf("nextMaintenanceWindowOccurrence returned error: %v", err)
+			}
+			if got := start.Format(time.RFC3339); got != tt.wantStart {
+				t.Fatalf("expected start %s, got %s", tt.wantStart, got)
+			}
+			if got := end.Format(time.RFC3339); got != tt.wantEnd {
+				t.Fatalf("expected end %s, got %s", tt.wantEnd, got)
+			}
+		})
+	}
+
+	if _, err := pspAnnouncementTimeZone("Mars/Olympus"); err == nil {
+		t.Fatal("expected an unknown time zone to be rejected")
+	}
+}
+
+func TestPSPAnnouncementWindowLocation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	account := providerclient.NewAccount(nil, &client.CurrentUser{TimeZone: "America/New_York"})
+
+	loc, err := pspAnnouncementWindowLocation(ctx, account, types.StringNull())
+	if err != nil || loc.String() != "America/New_York" {
+		t.Fatalf("expected the account time zone, got %v, %v", loc, err)
+	}
+	loc, err = pspAnnouncementWindowLocation(ctx, account, types.StringValue("Europe/Berlin"))
+	if err != nil || loc.String() != "Europe/Berlin" {
+		t.Fatalf("expected time_zone to override the account time zone, got %v, %v", loc, err)
+	}
+
+	// Without a time zone from the account there is no UTC fallback.
+	unknown := providerclient.NewAccount(nil, &client.CurrentUser{})
+	if _, err := pspAnnouncementWindowLocation(ctx, unknown, types.StringNull()); err == nil || !strings.Contains(err.Error(), "set time_zone") {
+		t.Fatalf("expected an error asking for time_zone, got %v", err)
+	}
+	if _, err := pspAnnouncementWindowLocation(ctx, nil, types.StringNull()); err == nil {
+		t.Fatal("expected an error without an account")
+	}
+}
+
+func TestResolvePSPAnnouncementWindowSchedule(t *testing.T) {
+	t.Parallel()
+
+	var windowReads int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/maintenance-windows/9":
+			windowReads++
+			_, _ = w.Write([]byte(`{"id":9,"interval":"daily","time":"22:00:00","duration":60}`))
+		case "/maintenance-windows/11":
+			_, _ = w.Write([]byte(`{"id":11,"interval":"once","date":"2030-01-16","time":"22:00:00","duration":60}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c := client.NewClient("test-api-key")
+	c.SetBaseURL(server.URL)
+
+	before := time.Date(2030, 1, 16, 12, 0, 0, 0, time.UTC)
+	during := time.Date(2030, 1, 16, 22, 30, 0, 0, time.UTC)
+	after := time.Date(2030, 1, 17, 9, 0, 0, 0, time.UTC)
+
+	created, err := resolvePSPAnnouncementWindowSchedule(context.Background(), c, 9, time.UTC, nil, before)
+	if err != nil {
+		t.Fatalf("resolve before window: %v", err)
+	}
+	want := pspAnnouncementWindowSchedule{StartDate: "2030-01-16T22:00:00Z", EndDate: "2030-01-16T23:00:00Z", Status: "pending"}
+	if created != want {
+		t.Fatalf("expected %+v, got %+v", want, created)
+	}
+
+	state := pspAnnouncementResourceModel{
+		MaintenanceWindowID: types.Int64Value(9),
+		StartDate:           types.StringValue(created.StartDate),
+		EndDate:             types.StringValue(created.EndDate),
+		Status:              types.StringValue(created.Status),
+	}
+
+	published, err := resolvePSPAnnouncementWindowSchedule(context.Background(), c, 9, time.UTC, &state, during)
+	if err != nil {
+		t.Fatalf("resolve during window: %v", err)
+	}
+	if published.Status != "published" || published.StartDate != created.StartDate {
+		t.Fatalf("expected published status on the anchored occurrence, got %+v", published)
+	}
+
+	// Later applies during the occurrence keep the anchor without reading the window.
+	state.Status = types.StringValue(published.Status)
+	readsBefore := windowReads
+	if again, err := resolvePSPAnnouncementWindowSchedule(context.Background(), c, 9, time.UTC, &state, during.Add(10*time.Minute)); err != nil || again != published {
+		t.Fatalf("expected the anchored occurrence again, got %+v, %v", again, err)
+	}
+	if windowReads != readsBefore {
+		t.Fatal("expected an anchored announcement not to re-read the maintenance window")
+	}
+
+	// Once the occurrence has ended, the recurring window moves the
+	// announcement on to its next occurrence.
+	next, err := resolvePSPAnnouncementWindowSchedule(context.Background(), c, 9, time.UTC, &state, after)
+	if err != nil {
+		t.Fatalf("resolve after window: %v", err)
+	}
+	want = pspAnnouncementWindowSchedule{StartDate: "2030-01-17T22:00:00Z", EndDate: "2030-01-17T23:00:00Z", Status: "pending"}
+	if next != want {
+		t.Fatalf("expected the next occurrence %+v, got %+v", want, next)
+	}
+
+	// A window without a later occurrence leaves the announcement archived.
+	once := pspAnnouncementResourceModel{
+		MaintenanceWindowID: types.Int64Value(11),
+		StartDate:           types.StringValue(created.StartDate),
+		EndDate:             types.StringValue(created.EndDate),
+		Status:              types.StringValue("published"),
+	}
+	archived, err := resolvePSPAnnouncementWindowSchedule(context.Background(), c, 11, time.UTC, &once, after)
+	if err != nil {
+		t.Fatalf("resolve after a one-time window: %v", err)
+	}
+	if archived.Status != "archived" || archived.EndDate != created.EndDate {
+		t.Fatalf("expected archived status on the anchored occurrence, got %+v", archived)
+	}
+
+	// Switching to another window starts over from that window's schedule.
+	if _, err := resolvePSPAnnouncementWindowSchedule(context.Background(), c, 10, time.UTC, &state, after); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected missing window error, got %v", err)
+	}
+}
+
+func TestAnchoredPSPAnnouncementWindowScheduleNeverRegresses(t *testing.T) {
+	t.Parallel()
+
+	state := pspAnnouncementResourceModel{
+		MaintenanceWindowID: types.Int64Value(9),
+		StartDate:           types.StringValue("2030-01-16T22:00:00Z"),
+		EndDate:             types.StringValue("2030-01-16T23:00:00Z"),
+		Status:              types.StringValue("archived"),
+	}
+
+	schedule, ok := anchoredPSPAnnouncementWindowSchedule(9, &state, time.Date(2030, 1, 16, 22, 30, 0, 0, time.UTC))
+	if !ok {
+		t.Fatal("expected archived announcement to stay anchored")
+	}
+	if schedule.Status != "archived" {
+		t.Fatalf("expected status to stay archived, got %q", schedule.Status)
+	}
+
+	state.Status = types.StringValue("pending")
+	if _, ok := anchoredPSPAnnouncementWindowSchedule(9, &state, time.Date(2030, 1, 16, 12, 0, 0, 0, time.UTC)); ok {
+		t.Fatal("expected a pending announcement before its occurrence to follow the window")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
 )
 
@@ -27,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure      = &pspAnnouncementResource{}
 	_ resource.ResourceWithImportState    = &pspAnnouncementResource{}
 	_ resource.ResourceWithValidateConfig = &pspAnnouncementResource{}
+	_ resource.ResourceWithModifyPlan     = &pspAnnouncementResource{}
 )
 
 // NewResource returns the PSP announcement resource implementation.
@@ -35,22 +39,32 @@ func NewResource() resource.Resource {
 }
 
 type pspAnnouncementResource struct {
-	client *client.Client
+	client  *client.Client
+	account *providerclient.Account
 }
 
 type pspAnnouncementResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	PSPID        types.Int64  `tfsdk:"psp_id"`
-	Title        types.String `tfsdk:"title"`
-	Content      types.String `tfsdk:"content"`
-	Status       types.String `tfsdk:"status"`
-	Type         types.String `tfsdk:"type"`
-	StartDate    types.String `tfsdk:"start_date"`
-	EndDate      types.String `tfsdk:"end_date"`
-	IsPinned     types.Bool   `tfsdk:"is_pinned"`
-	CreationDate types.String `tfsdk:"creation_date"`
+	ID                  types.String   `tfsdk:"id"`
+	PSPID               types.Int64    `tfsdk:"psp_id"`
+	Title               types.String   `tfsdk:"title"`
+	Content             types.String   `tfsdk:"content"`
+	Status              types.String   `tfsdk:"status"`
+	Type                types.String   `tfsdk:"type"`
+	StartDate           types.String   `tfsdk:"start_date"`
+	EndDate             types.String   `tfsdk:"end_date"`
+	MaintenanceWindowID types.Int64    `tfsdk:"maintenance_window_id"`
+	TimeZone            types.String   `tfsdk:"time_zone"`
+	IsPinned            types.Bool     `tfsdk:"is_pinned"`
+	CreationDate        types.String   `tfsdk:"creation_date"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
+// Default waits used when the timeouts block does not override them.
+const (
+	pspAnnouncementSettleTimeout     = 90 * time.Second
+	pspAnnouncementReadSettleTimeout = 30 * time.Second
+)
+
 type pspAnnouncementExpected struct {
 	Title     string
 	Content   string
@@ -61,7 +75,12 @@ type pspAnnouncementExpected struct {
 }
 
 func (r *pspAnnouncementResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = providerclient.FromResourceConfigure(req, resp)
+	data := providerclient.DataFromResourceConfigure(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+	r.account = data.Account
 }
 
 func (r *pspAnnouncementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -97,14 +116,15 @@ func (r *pspAnnouncementResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"content": schema.StringAttribute{
-				Description: "Announcement content.",
+				Description: "Announcement content. Supports paragraphs, lists, bold, italic, inline code, http/https/mailto links, and the inline HTML tags a, b, strong, i, em, u, code, and br.",
 				Required:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 2000),
+					pspAnnouncementContentValidator{},
 				},
 			},
 			"status": schema.StringAttribute{
-				Description: "Announcement status. Valid values are offline, pending, published, and archived.",
+				Description: "Announcement status. Valid values are offline, pending, published, and archived. Derived from the maintenance window when maintenance_window_id is set.",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("pending"),
@@ -113,7 +133,7 @@ func (r *pspAnnouncementResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"type": schema.StringAttribute{
-				Description: "Announcement type. Valid values are info, maintenance, and issue.",
+				Description: "Announcement type. Valid values are info, maintenance, and issue. Always maintenance when maintenance_window_id is set.",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("info"),
@@ -122,12 +142,30 @@ func (r *pspAnnouncementResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"start_date": schema.StringAttribute{
-				Description: "Announcement start date as an RFC3339 timestamp, for example 2030-01-01T00:00:00Z.",
-				Required:    true,
+				Description: "Announcement start date as an RFC3339 timestamp, for example 2030-01-01T00:00:00Z. Required unless maintenance_window_id is set, in which case it is derived from the window's next occurrence.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"end_date": schema.StringAttribute{
-				Description: "Optional announcement end date as an RFC3339 timestamp. Omit or set to null to leave the announcement without an end date.",
+				Description: "Optional announcement end date as an RFC3339 timestamp. Omit or set to null to leave the announcement without an end date. Derived from the window's next occurrence when maintenance_window_id is set.",
 				Optional:    true,
+				Computed:    true,
+			},
+			"maintenance_window_id": schema.Int64Attribute{
+				Description: "Optional maintenance window ID. When set, start_date and end_date follow the window's next occurrence (read in the account time zone), " +
+					"type is set to maintenance, and status moves from pending to published on applies made during that occurrence. Applies made after it ends " +
+					"move the announcement to the window's next occurrence, or archive it when the window does not occur again. " +
+					"Conflicts with start_date, end_date, and status.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"time_zone": schema.StringAttribute{
+				Description: "IANA time zone of the UptimeRobot account, such as Europe/Berlin. The API reads maintenance window dates and times in " +
+					"the account time zone. Defaults to the time zone the API reports for the account; planning fails when it reports none and " +
+					"this is not set. Only applies when maintenance_window_id is set.",
+				Optional: true,
 			},
 			"is_pinned": schema.BoolAttribute{
 				Description: "Whether this announcement is pinned on its public status page. Omit this attribute to leave pinned-announcement ownership unmanaged by this resource.",
@@ -138,6 +176,9 @@ func (r *pspAnnouncementResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			optimeout.BlockName: optimeout.Block(),
+		},
 	}
 }
 
@@ -152,6 +193,30 @@ func (r *pspAnnouncementResource) ValidateConfig(
 		return
 	}
 
+	if !cfg.TimeZone.IsNull() && !cfg.TimeZone.IsUnknown() {
+		if _, err := pspAnnouncementTimeZone(cfg.TimeZone.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("time_zone"), "Invalid Time Zone", err.Error())
+		}
+	}
+	if !cfg.MaintenanceWindowID.IsNull() {
+		validatePSPAnnouncementWindowConfig(cfg, resp)
+		return
+	}
+	if !cfg.TimeZone.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("time_zone"),
+			"Unused announcement time zone",
+			"time_zone only applies when maintenance_window_id is set; start_date and end_date carry their own offset.",
+		)
+	}
+	if cfg.StartDate.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start_date"),
+			"Missing announcement start date",
+			"start_date is required unless maintenance_window_id is set.",
+		)
+	}
+
 	start, startOK := validatePSPAnnouncementTimestamp(cfg.StartDate, path.Root("start_date"), resp)
 	end, endOK := validatePSPAnnouncementTimestamp(cfg.EndDate, path.Root("end_date"), resp)
 	if startOK && endOK {
@@ -167,6 +232,109 @@ func (r *pspAnnouncementResource) ValidateConfig(
 	}
 }
 
+func validatePSPAnnouncementWindowConfig(cfg pspAnnouncementResourceModel, resp *resource.ValidateConfigResponse) {
+	for attr, value := range map[string]types.String{
+		"start_date": cfg.StartDate,
+		"end_date":   cfg.EndDate,
+		"status":     cfg.Status,
+	} {
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Conflicting announcement schedule",
+				fmt.Sprintf("%s cannot be set together with maintenance_window_id; it is derived from the maintenance window.", attr),
+			)
+		}
+	}
+	if !cfg.Type.IsNull() && !cfg.Type.IsUnknown() && normalizePSPAnnouncementType(cfg.Type.ValueString()) != "maintenance" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid announcement type",
+			"type must be maintenance or omitted when maintenance_window_id is set.",
+		)
+	}
+}
+
+func (r *pspAnnouncementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config pspAnnouncementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !pspAnnouncementWindowLinked(config) {
+		// end_date is computed for linked announcements; keep an omitted end_date null otherwise.
+		if config.EndDate.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("end_date"), types.StringNull())...)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"), types.StringValue("maintenance"))...)
+
+	if config.MaintenanceWindowID.IsUnknown() || config.TimeZone.IsUnknown() || r.client == nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("start_date"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("end_date"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+		return
+	}
+
+	var prior *pspAnnouncementResourceModel
+	if !req.State.Raw.IsNull() {
+		var state pspAnnouncementResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		prior = &state
+	}
+
+	loc, err := pspAnnouncementWindowLocation(ctx, r.account, config.TimeZone)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("time_zone"), "Error resolving maintenance window time zone", err.Error())
+		return
+	}
+	schedule, err := resolvePSPAnnouncementWindowSchedule(ctx, r.client, config.MaintenanceWindowID.ValueInt64(), loc, prior, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("maintenance_window_id"), "Error resolving maintenance window schedule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("start_date"), types.StringValue(schedule.StartDate))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("end_date"), types.StringValue(schedule.EndDate))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringValue(schedule.Status))...)
+}
+
+// applyWindowSchedule fills schedule attributes left unknown at plan time, for example when the
+// maintenance window is created in the same apply.
+func (r *pspAnnouncementResource) applyWindowSchedule(ctx context.Context, plan *pspAnnouncementResourceModel, prior *pspAnnouncementResourceModel) error {
+	if !pspAnnouncementWindowLinked(*plan) {
+		return nil
+	}
+	plan.Type = types.StringValue("maintenance")
+	if !plan.StartDate.IsUnknown() && !plan.EndDate.IsUnknown() && !plan.Status.IsUnknown() {
+		return nil
+	}
+
+	loc, err := pspAnnouncementWindowLocation(ctx, r.account, plan.TimeZone)
+	if err != nil {
+		return err
+	}
+	schedule, err := resolvePSPAnnouncementWindowSchedule(ctx, r.client, plan.MaintenanceWindowID.ValueInt64(), loc, prior, time.Now())
+	if err != nil {
+		return err
+	}
+	plan.StartDate = types.StringValue(schedule.StartDate)
+	plan.EndDate = types.StringValue(schedule.EndDate)
+	plan.Status = types.StringValue(schedule.Status)
+	return nil
+}
+
 func (r *pspAnnouncementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan pspAnnouncementResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -174,6 +342,19 @@ func (r *pspAnnouncementResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := optimeout.Create(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := createTimeout.Context(ctx)
+	defer cancel()
+
+	if err := r.applyWindowSchedule(ctx, &plan, nil); err != nil {
+		apidiag.AddAttributeRequestError(&resp.Diagnostics, path.Root("maintenance_window_id"), "Error resolving maintenance window schedule", err.Error(), err)
+		return
+	}
+
 	createReq, expected, err := pspAnnouncementCreateRequest(plan)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid PSP announcement configuration", err.Error())
@@ -182,12 +363,12 @@ func (r *pspAnnouncementResource) Create(ctx context.Context, req resource.Creat
 
 	announcement, err := r.client.CreatePSPAnnouncement(ctx, plan.PSPID.ValueInt64(), createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating PSP announcement", err.Error())
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, nil, "Error creating PSP announcement", err.Error(), err)
 		return
 	}
 
 	announcementForState := announcement
-	if settled, err := waitPSPAnnouncementSettled(ctx, r.client, plan.PSPID.ValueInt64(), announcement.ID, expected, 90*time.Second); err != nil {
+	if settled, err := waitPSPAnnouncementSettled(ctx, r.client, plan.PSPID.ValueInt64(), announcement.ID, expected, createTimeout.Wait(pspAnnouncementSettleTimeout)); err != nil {
 		if ctx.Err() != nil {
 			resp.Diagnostics.AddError("Error waiting for PSP announcement stabilization", err.Error())
 			return
@@ -201,17 +382,19 @@ func (r *pspAnnouncementResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	if pspAnnouncementPinManaged(plan.IsPinned) {
-		if err := reconcilePSPAnnouncementPin(ctx, r.client, plan.PSPID.ValueInt64(), announcement.ID, plan.IsPinned.ValueBool(), false); err != nil {
+		if err := reconcilePSPAnnouncementPin(ctx, r.client, plan.PSPID.ValueInt64(), announcement.ID, plan.IsPinned.ValueBool(), false, createTimeout.Wait(pspAnnouncementSettleTimeout)); err != nil {
 			if cleanupErr := archiveCreatedPSPAnnouncementAfterPinFailure(ctx, r.client, plan.PSPID.ValueInt64(), announcement.ID); cleanupErr != nil {
-				resp.Diagnostics.AddError(
+				apidiag.AddRequestError(&resp.Diagnostics,
 					"Error managing PSP announcement pin state",
 					fmt.Sprintf("%s. Terraform also failed to archive the newly created announcement %d during cleanup: %v", err.Error(), announcement.ID, cleanupErr),
+					err,
 				)
 				return
 			}
-			resp.Diagnostics.AddError(
+			apidiag.AddRequestError(&resp.Diagnostics,
 				"Error managing PSP announcement pin state",
 				fmt.Sprintf("%s. Terraform archived the newly created announcement %d to avoid leaving it unmanaged.", err.Error(), announcement.ID),
+				err,
 			)
 			return
 		}
@@ -235,6 +418,14 @@ func (r *pspAnnouncementResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := optimeout.Read(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := readTimeout.Context(ctx)
+	defer cancel()
+
 	announcement, err := r.client.GetPSPAnnouncement(ctx, pspID, announcementID)
 	if err != nil {
 		if client.IsNotFound(err) {
@@ -247,7 +438,7 @@ func (r *pspAnnouncementResource) Read(ctx context.Context, req resource.ReadReq
 
 	state.applyAPI(announcement)
 	if pspAnnouncementPinManaged(state.IsPinned) {
-		pinned, err := readPSPAnnouncementPinState(ctx, r.client, pspID, announcementID, state.IsPinned.ValueBool(), 30*time.Second)
+		pinned, err := readPSPAnnouncementPinState(ctx, r.client, pspID, announcementID, state.IsPinned.ValueBool(), readTimeout.Wait(pspAnnouncementReadSettleTimeout))
 		if err != nil {
 			resp.Diagnostics.AddError("Error reading PSP announcement pin state", err.Error())
 			return
@@ -271,20 +462,50 @@ func (r *pspAnnouncementResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, diags := optimeout.Update(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := updateTimeout.Context(ctx)
+	defer cancel()
+
+	if err := r.applyWindowSchedule(ctx, &plan, &state); err != nil {
+		apidiag.AddAttributeRequestError(&resp.Diagnostics, path.Root("maintenance_window_id"), "Error resolving maintenance window schedule", err.Error(), err)
+		return
+	}
+
 	updateReq, expected, err := pspAnnouncementUpdateRequest(plan, state)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid PSP announcement configuration", err.Error())
 		return
 	}
 
+	// A window-linked announcement whose occurrence has ended is archived through the
+	// dedicated archive call after its remaining fields are updated.
+	archive := pspAnnouncementWindowLinked(plan) &&
+		expected.Status == "archived" &&
+		normalizePSPAnnouncementStatus(state.Status.ValueString()) != "archived"
+	if archive {
+		updateReq.Status = nil
+	}
+
 	announcement, err := r.client.UpdatePSPAnnouncement(ctx, plan.PSPID.ValueInt64(), announcementID, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating PSP announcement", err.Error())
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, nil, "Error updating PSP announcement", err.Error(), err)
 		return
 	}
 
+	if archive {
+		announcement, err = r.client.ArchivePSPAnnouncement(ctx, plan.PSPID.ValueInt64(), announcementID)
+		if err != nil {
+			apidiag.AddRequestError(&resp.Diagnostics, "Error archiving PSP announcement", err.Error(), err)
+			return
+		}
+	}
+
 	announcementForState := announcement
-	if settled, err := waitPSPAnnouncementSettled(ctx, r.client, plan.PSPID.ValueInt64(), announcementID, expected, 90*time.Second); err != nil {
+	if settled, err := waitPSPAnnouncementSettled(ctx, r.client, plan.PSPID.ValueInt64(), announcementID, expected, updateTimeout.Wait(pspAnnouncementSettleTimeout)); err != nil {
 		if ctx.Err() != nil {
 			resp.Diagnostics.AddError("Error waiting for PSP announcement stabilization", err.Error())
 			return
@@ -299,8 +520,8 @@ func (r *pspAnnouncementResource) Update(ctx context.Context, req resource.Updat
 
 	if pspAnnouncementPinManaged(plan.IsPinned) {
 		forceUnpin := pspAnnouncementPinManaged(state.IsPinned) && state.IsPinned.ValueBool()
-		if err := reconcilePSPAnnouncementPin(ctx, r.client, plan.PSPID.ValueInt64(), announcementID, plan.IsPinned.ValueBool(), forceUnpin); err != nil {
-			resp.Diagnostics.AddError("Error managing PSP announcement pin state", err.Error())
+		if err := reconcilePSPAnnouncementPin(ctx, r.client, plan.PSPID.ValueInt64(), announcementID, plan.IsPinned.ValueBool(), forceUnpin, updateTimeout.Wait(pspAnnouncementSettleTimeout)); err != nil {
+			apidiag.AddRequestError(&resp.Diagnostics, "Error managing PSP announcement pin state", err.Error(), err)
 			return
 		}
 	}
@@ -322,10 +543,18 @@ func (r *pspAnnouncementResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := optimeout.Delete(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := deleteTimeout.Context(ctx)
+	defer cancel()
+
 	if pspAnnouncementPinManaged(state.IsPinned) {
 		forceUnpin := state.IsPinned.ValueBool()
-		if err := unpinPSPAnnouncement(ctx, r.client, state.PSPID.ValueInt64(), announcementID, forceUnpin); err != nil {
-			resp.Diagnostics.AddError("Error unpinning PSP announcement before archive", err.Error())
+		if err := unpinPSPAnnouncement(ctx, r.client, state.PSPID.ValueInt64(), announcementID, forceUnpin, deleteTimeout.Wait(pspAnnouncementSettleTimeout)); err != nil {
+			apidiag.AddRequestError(&resp.Diagnostics, "Error unpinning PSP announcement before archive", err.Error(), err)
 			return
 		}
 	}
@@ -335,7 +564,7 @@ func (r *pspAnnouncementResource) Delete(ctx context.Context, req resource.Delet
 		if client.IsNotFound(err) {
 			return
 		}
-		resp.Diagnostics.AddError("Error archiving PSP announcement", err.Error())
+		apidiag.AddRequestError(&resp.Diagnostics, "Error archiving PSP announcement", err.Error(), err)
 		return
 	}
 
@@ -473,7 +702,7 @@ func waitPSPAnnouncementSettled(
 	}
 }
 
-func reconcilePSPAnnouncementPin(ctx context.Context, c *client.Client, pspID, announcementID int64, desired bool, forceUnpin bool) error {
+func reconcilePSPAnnouncementPin(ctx context.Context, c *client.Client, pspID, announcementID int64, desired bool, forceUnpin bool, timeout time.Duration) error {
 	currentlyPinned, err := pspAnnouncementIsPinned(ctx, c, pspID, announcementID)
 	if err != nil {
 		return err
@@ -488,10 +717,10 @@ func reconcilePSPAnnouncementPin(ctx context.Context, c *client.Client, pspID, a
 		if err := c.PinPSPAnnouncement(ctx, pspID, announcementID); err != nil {
 			return err
 		}
-		return waitPSPAnnouncementPinSettled(ctx, c, pspID, announcementID, true, 90*time.Second)
+		return waitPSPAnnouncementPinSettled(ctx, c, pspID, announcementID, true, timeout)
 	}
 
-	return unpinPSPAnnouncement(ctx, c, pspID, announcementID, forceUnpin)
+	return unpinPSPAnnouncement(ctx, c, pspID, announcementID, forceUnpin, timeout)
 }
 
 func archiveCreatedPSPAnnouncementAfterPinFailure(ctx context.Context, c *client.Client, pspID, announcementID int64) error {
@@ -505,7 +734,7 @@ func archiveCreatedPSPAnnouncementAfterPinFailure(ctx context.Context, c *client
 	return err
 }
 
-func unpinPSPAnnouncement(ctx context.Context, c *client.Client, pspID, announcementID int64, force bool) error {
+func unpinPSPAnnouncement(ctx context.Context, c *client.Client, pspID, announcementID int64, force bool, timeout time.Duration) error {
 	currentlyPinned, err := pspAnnouncementIsPinned(ctx, c, pspID, announcementID)
 	if err != nil {
 		if client.IsNotFound(err) {
@@ -524,7 +753,7 @@ func unpinPSPAnnouncement(ctx context.Context, c *client.Client, pspID, announce
 		}
 		return err
 	}
-	return waitPSPAnnouncementPinSettled(ctx, c, pspID, announcementID, false, 90*time.Second)
+	return waitPSPAnnouncementPinSettled(ctx, c, pspID, announcementID, false, timeout)
 }
 
 func waitPSPAnnouncementPinSettled(
@@ -122,6 +122,31 @@ func TestAccPSPAnnouncementResource_PinUnpin(t *testing.T) {
 	})
 }
 
+func TestAccPSPAnnouncementResource_MaintenanceWindow(t *testing.T) {
+	if os.Getenv("UPTIMEROBOT_TEST_PSP_ANNOUNCEMENT") != "1" {
+		t.Skip("set UPTIMEROBOT_TEST_PSP_ANNOUNCEMENT=1 to run PSP announcement acceptance tests")
+	}
+
+	name := provideracctest.RandomName("acc-psp-ann-mw")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provideracctest.PreCheck(t) },
+		ProtoV6ProviderFactories: provideracctest.ProtoV6ProviderFactories,
+		CheckDestroy:             provideracctest.CheckPSPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPSPAnnouncementResourceWindowConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_psp_announcement.test", "type", "maintenance"),
+					resource.TestCheckResourceAttr("uptimerobot_psp_announcement.test", "status", "pending"),
+					resource.TestCheckResourceAttr("uptimerobot_psp_announcement.test", "start_date", "2030-01-01T22:00:00Z"),
+					resource.TestCheckResourceAttr("uptimerobot_psp_announcement.test", "end_date", "2030-01-01T23:30:00Z"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPSPAnnouncementResourceConfig(
 	name string,
 	title string,
@@ -176,6 +201,30 @@ resource "uptimerobot_psp_announcement" "test" {
 `, name, pinnedConfig)
 }
 
+func testAccPSPAnnouncementResourceWindowConfig(name string) string {
+	return provideracctest.ProviderConfig() + fmt.Sprintf(`
+resource "uptimerobot_psp" "test" {
+  name         = %q
+  subscription = true
+}
+
+resource "uptimerobot_maintenance_window" "test" {
+  name     = %q
+  interval = "once"
+  date     = "2030-01-01"
+  time     = "22:00:00"
+  duration = 90
+}
+
+resource "uptimerobot_psp_announcement" "test" {
+  psp_id                = tonumber(uptimerobot_psp.test.id)
+  title                 = "Planned maintenance"
+  content               = "We will perform scheduled maintenance."
+  maintenance_window_id = tonumber(uptimerobot_maintenance_window.test.id)
+}
+`, name, name)
+}
+
 func testAccPSPAnnouncementImportStateID(s *terraform.State) (string, error) {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "uptimerobot_psp_announcement" {
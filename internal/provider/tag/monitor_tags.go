@@ -0,0 +1,132 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+// taggedMonitorIDs returns the sorted IDs of monitors that carry tagName.
+func taggedMonitorIDs(ctx context.Context, apiClient *client.Client, tagName string) ([]int64, error) {
+	monitors, err := taggedMonitors(ctx, apiClient, tagName)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(monitors))
+	for _, monitor := range monitors {
+		ids = append(ids, monitor.ID)
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+func taggedMonitors(ctx context.Context, apiClient *client.Client, tagName string) ([]client.Monitor, error) {
+	monitors, err := apiClient.GetMonitorsFiltered(ctx, client.MonitorListFilters{Tags: []string{tagName}})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]client.Monitor, 0, len(monitors))
+	for _, monitor := range monitors {
+		// Guard against the API matching tags loosely or ignoring the filter.
+		if slices.Contains(monitorTagNames(monitor), tagName) {
+			out = append(out, monitor)
+		}
+	}
+	return out, nil
+}
+
+// reconcileTagMonitors adds tagName to desired monitors and removes it from every other monitor that carries it.
+func reconcileTagMonitors(ctx context.Context, apiClient *client.Client, tagName string, desired []int64) error {
+	current, err := taggedMonitors(ctx, apiClient, tagName)
+	if err != nil {
+		return fmt.Errorf("listing monitors tagged %q: %w", tagName, err)
+	}
+
+	currentByID := make(map[int64]client.Monitor, len(current))
+	for _, monitor := range current {
+		currentByID[monitor.ID] = monitor
+	}
+
+	for _, monitorID := range desired {
+		if _, ok := currentByID[monitorID]; ok {
+			continue
+		}
+		if _, err := apiClient.UpdateMonitorTags(ctx, monitorID, func(names []string) []string {
+			names = append(names, tagName)
+			slices.Sort(names)
+			return slices.Compact(names)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, monitor := range current {
+		if slices.Contains(desired, monitor.ID) {
+			continue
+		}
+		if _, err := apiClient.UpdateMonitorTags(ctx, monitor.ID, func(names []string) []string {
+			return replaceTagName(names, tagName, "")
+		}); err != nil && !client.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// renameMonitorTags rewrites oldName to newName on every monitor that still reports oldName,
+// so renaming a tag does not leave the old name behind as an orphaned tag.
+func renameMonitorTags(ctx context.Context, apiClient *client.Client, oldName, newName string) error {
+	monitors, err := taggedMonitors(ctx, apiClient, oldName)
+	if err != nil {
+		return fmt.Errorf("listing monitors tagged %q: %w", oldName, err)
+	}
+
+	for _, monitor := range monitors {
+		if _, err := apiClient.UpdateMonitorTags(ctx, monitor.ID, func(names []string) []string {
+			return replaceTagName(names, oldName, newName)
+		}); err != nil && !client.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func monitorTagNames(monitor client.Monitor) []string {
+	names := make([]string, 0, len(monitor.Tags))
+	for _, tag := range monitor.Tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+// replaceTagName replaces oldName with newName in names. An empty newName removes oldName.
+// The result is sorted and free of duplicates.
+func replaceTagName(names []string, oldName, newName string) []string {
+	out := make([]string, 0, len(names)+1)
+	for _, name := range names {
+		if name == oldName {
+			if newName == "" {
+				continue
+			}
+			name = newName
+		}
+		out = append(out, name)
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+func tagMonitorIDsFromSet(ctx context.Context, value types.Set) ([]int64, diag.Diagnostics) {
+	var monitorIDs []int64
+	diags := value.ElementsAs(ctx, &monitorIDs, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	slices.Sort(monitorIDs)
+	return slices.Compact(monitorIDs), diags
+}
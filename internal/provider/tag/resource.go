@@ -0,0 +1,338 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/apidiag"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/optimeout"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &tagResource{}
+	_ resource.ResourceWithConfigure   = &tagResource{}
+	_ resource.ResourceWithImportState = &tagResource{}
+)
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return &tagResource{}
+}
+
+// tagResource is the resource implementation.
+type tagResource struct {
+	client *client.Client
+}
+
+type tagResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	Name       types.String   `tfsdk:"name"`
+	Color      types.String   `tfsdk:"color"`
+	MonitorIDs types.Set      `tfsdk:"monitor_ids"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// tagDeleteTimeout is how long Delete waits for the tag to disappear when the
+// timeouts block does not override it.
+const tagDeleteTimeout = 90 * time.Second
+
+// Configure adds the provider configured client to the resource.
+func (r *tagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = providerclient.FromResourceConfigure(req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *tagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+// Schema defines the schema for the resource.
+func (r *tagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a UptimeRobot monitor tag, its color, and optionally its monitor assignments.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Tag identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Tag name. Renaming a tag also rewrites the tag name on every monitor that carries it.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Tag color as a `#RRGGBB` hex value. If omitted, UptimeRobot assigns a color.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(tagColorPattern, "must be a hex color such as #1E90FF"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor_ids": schema.SetAttribute{
+				MarkdownDescription: "Authoritative set of monitor IDs that carry this tag. The tag is removed from monitors missing from the set. " +
+					"If omitted, monitor assignments are not managed by this resource. " +
+					"Only list monitors whose `uptimerobot_monitor` does not manage tags (no `tags` and no provider `default_tags`): such a monitor replaces its whole tag list on every update.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			optimeout.BlockName: optimeout.Block(),
+		},
+	}
+}
+
+// Create creates the tag.
+func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := optimeout.Create(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := createTimeout.Context(ctx)
+	defer cancel()
+
+	tag, err := r.client.CreateTag(ctx, &client.CreateTagRequest{
+		Name:  plan.Name.ValueString(),
+		Color: valueString(plan.Color),
+	})
+	if err != nil {
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, nil, "Error creating tag", err.Error(), err)
+		return
+	}
+
+	if !plan.MonitorIDs.IsNull() && !plan.MonitorIDs.IsUnknown() {
+		desired, diags := tagMonitorIDsFromSet(ctx, plan.MonitorIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := reconcileTagMonitors(ctx, r.client, tag.Name, desired); err != nil {
+			// The tag exists, so keep it in state and let the next apply finish assignments.
+			plan.applyAPI(tag)
+			tagged, listErr := taggedMonitorIDs(ctx, r.client, tag.Name)
+			if listErr != nil {
+				tagged = nil
+			}
+			plan.MonitorIDs, diags = types.SetValueFrom(ctx, types.Int64Type, tagged)
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			apidiag.AddAttributeRequestError(&resp.Diagnostics, path.Root("monitor_ids"), "Error assigning tag to monitors", err.Error(), err)
+			return
+		}
+	}
+
+	plan.applyAPI(tag)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the tag state.
+func (r *tagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := state.intID()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid tag ID", err.Error())
+		return
+	}
+
+	readTimeout, diags := optimeout.Read(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := readTimeout.Context(ctx)
+	defer cancel()
+
+	tag, err := r.client.GetTag(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading tag", err.Error())
+		return
+	}
+
+	if !state.MonitorIDs.IsNull() {
+		monitorIDs, err := taggedMonitorIDs(ctx, r.client, tag.Name)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading tagged monitors", err.Error())
+			return
+		}
+		var diags diag.Diagnostics
+		state.MonitorIDs, diags = types.SetValueFrom(ctx, types.Int64Type, monitorIDs)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	state.applyAPI(tag)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the tag. A rename also rewrites the tag name on attached monitors.
+func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tagResourceModel
+	var state tagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := state.intID()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid tag ID", err.Error())
+		return
+	}
+
+	updateTimeout, diags := optimeout.Update(ctx, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := updateTimeout.Context(ctx)
+	defer cancel()
+
+	oldName := state.Name.ValueString()
+	newName := plan.Name.ValueString()
+
+	tag, err := r.client.UpdateTag(ctx, id, &client.UpdateTagRequest{
+		Name:  newName,
+		Color: valueString(plan.Color),
+	})
+	if err != nil {
+		apidiag.AddError(ctx, &resp.Diagnostics, req.Plan.Schema, nil, "Error updating tag", err.Error(), err)
+		return
+	}
+
+	if oldName != newName {
+		if err := renameMonitorTags(ctx, r.client, oldName, newName); err != nil {
+			apidiag.AddAttributeRequestError(&resp.Diagnostics, path.Root("name"), "Error renaming tag on monitors", err.Error(), err)
+			return
+		}
+	}
+
+	if !plan.MonitorIDs.IsNull() && !plan.MonitorIDs.IsUnknown() {
+		desired, diags := tagMonitorIDsFromSet(ctx, plan.MonitorIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := reconcileTagMonitors(ctx, r.client, newName, desired); err != nil {
+			apidiag.AddAttributeRequestError(&resp.Diagnostics, path.Root("monitor_ids"), "Error updating tagged monitors", err.Error(), err)
+			return
+		}
+	}
+
+	plan.applyAPI(tag)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the tag.
+func (r *tagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := state.intID()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid tag ID", err.Error())
+		return
+	}
+
+	deleteTimeout, diags := optimeout.Delete(ctx, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := deleteTimeout.Context(ctx)
+	defer cancel()
+
+	if err := r.client.DeleteTag(ctx, id); err != nil {
+		apidiag.AddRequestError(&resp.Diagnostics, "Error deleting tag", err.Error(), err)
+		return
+	}
+
+	if err := r.client.WaitTagDeleted(ctx, id, deleteTimeout.Wait(tagDeleteTimeout)); err != nil {
+		resp.Diagnostics.AddError("Error waiting for tag deletion", err.Error())
+	}
+}
+
+// ImportState imports an existing resource into Terraform.
+func (r *tagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m *tagResourceModel) applyAPI(tag *client.UserTag) {
+	m.ID = types.StringValue(strconv.FormatInt(tag.ID, 10))
+	m.Name = types.StringValue(tag.Name)
+
+	switch {
+	case tag.Color == "":
+		if m.Color.IsUnknown() {
+			m.Color = types.StringNull()
+		}
+	case !m.Color.IsNull() && !m.Color.IsUnknown() && strings.EqualFold(m.Color.ValueString(), tag.Color):
+		// Keep the configured casing; the API may normalize hex digits.
+	default:
+		m.Color = types.StringValue(tag.Color)
+	}
+}
+
+func (m tagResourceModel) intID() (int64, error) {
+	if m.ID.IsNull() || m.ID.IsUnknown() || m.ID.ValueString() == "" {
+		return 0, fmt.Errorf("id is not set")
+	}
+	id, err := strconv.ParseInt(m.ID.ValueString(), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse %q as an integer ID: %w", m.ID.ValueString(), err)
+	}
+	if id < 1 {
+		return 0, fmt.Errorf("id must be a positive integer, got %d from %q", id, m.ID.ValueString())
+	}
+	return id, nil
+}
@@ -0,0 +1,73 @@
+//go:build acceptance
+
+package tag_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	provideracctest "github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/acctest"
+)
+
+func testAccTagResourceConfig(tagName, color, monitorName string) string {
+	url := provideracctest.UniqueURL(monitorName)
+	return provideracctest.ProviderConfig() + fmt.Sprintf(`
+resource "uptimerobot_monitor" "test" {
+  name     = %q
+  url      = %q
+  type     = "HTTP"
+  interval = 300
+  timeout  = 30
+}
+
+resource "uptimerobot_tag" "test" {
+  name        = %q
+  color       = %q
+  monitor_ids = [tonumber(uptimerobot_monitor.test.id)]
+}
+`, monitorName, url, tagName, color)
+}
+
+func TestAccTagResource_RenameAndColor(t *testing.T) {
+	tagName := acctest.RandomWithPrefix("acc-tag")
+	renamed := tagName + "-renamed"
+	monitorName := acctest.RandomWithPrefix("acc-tag-monitor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provideracctest.PreCheck(t) },
+		ProtoV6ProviderFactories: provideracctest.ProtoV6ProviderFactories,
+		CheckDestroy:             provideracctest.CheckMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagResourceConfig(tagName, "#1E90FF", monitorName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("uptimerobot_tag.test", "id"),
+					resource.TestCheckResourceAttr("uptimerobot_tag.test", "name", tagName),
+					resource.TestCheckResourceAttr("uptimerobot_tag.test", "color", "#1E90FF"),
+					resource.TestCheckResourceAttr("uptimerobot_tag.test", "monitor_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccTagResourceConfig(renamed, "#2E8B57", monitorName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimerobot_tag.test", "name", renamed),
+					resource.TestCheckResourceAttr("uptimerobot_tag.test", "color", "#2E8B57"),
+					resource.TestCheckResourceAttr("uptimerobot_tag.test", "monitor_ids.#", "1"),
+				),
+			},
+			{
+				Config:             testAccTagResourceConfig(renamed, "#2E8B57", monitorName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:            "uptimerobot_tag.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"monitor_ids"},
+			},
+		},
+	})
+}
@@ -0,0 +1,183 @@
+package tag
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+func TestTagResource_Metadata(t *testing.T) {
+	t.Parallel()
+
+	r := NewResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "uptimerobot"}, resp)
+
+	if resp.TypeName != "uptimerobot_tag" {
+		t.Fatalf("unexpected type name %q", resp.TypeName)
+	}
+}
+
+func TestTagResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	r := NewResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	for _, name := range []string{"id", "name", "color", "monitor_ids"} {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Fatalf("expected schema attribute %q", name)
+		}
+	}
+}
+
+func TestTagColorPattern(t *testing.T) {
+	t.Parallel()
+
+	for _, valid := range []string{"#1E90FF", "#abcdef", "#000000"} {
+		if !tagColorPattern.MatchString(valid) {
+			t.Fatalf("expected %q to be valid", valid)
+		}
+	}
+	for _, invalid := range []string{"1E90FF", "#FFF", "#GGGGGG", "red", "#1E90FF0"} {
+		if tagColorPattern.MatchString(invalid) {
+			t.Fatalf("expected %q to be invalid", invalid)
+		}
+	}
+}
+
+func TestTagResourceModelApplyAPIKeepsConfiguredColorCasing(t *testing.T) {
+	t.Parallel()
+
+	model := tagResourceModel{Color: types.StringValue("#1e90ff")}
+	model.applyAPI(&client.UserTag{ID: 7, Name: "prod", Color: "#1E90FF"})
+	if model.Color.ValueString() != "#1e90ff" {
+		t.Fatalf("expected configured casing to be preserved, got %q", model.Color.ValueString())
+	}
+	if model.ID.ValueString() != "7" || model.Name.ValueString() != "prod" {
+		t.Fatalf("unexpected model %#v", model)
+	}
+
+	model = tagResourceModel{Color: types.StringUnknown()}
+	model.applyAPI(&client.UserTag{ID: 7, Name: "prod"})
+	if !model.Color.IsNull() {
+		t.Fatalf("expected unknown color without API value to become null, got %s", model.Color)
+	}
+
+	model = tagResourceModel{Color: types.StringValue("#000000")}
+	model.applyAPI(&client.UserTag{ID: 7, Name: "prod", Color: "#FFFFFF"})
+	if model.Color.ValueString() != "#FFFFFF" {
+		t.Fatalf("expected remote color drift to be surfaced, got %q", model.Color.ValueString())
+	}
+}
+
+func TestReplaceTagName(t *testing.T) {
+	t.Parallel()
+
+	got := replaceTagName([]string{"web", "old", "api"}, "old", "new")
+	if !slices.Equal(got, []string{"api", "new", "web"}) {
+		t.Fatalf("unexpected rename result %v", got)
+	}
+
+	got = replaceTagName([]string{"new", "old"}, "old", "new")
+	if !slices.Equal(got, []string{"new"}) {
+		t.Fatalf("expected duplicate names to collapse, got %v", got)
+	}
+
+	got = replaceTagName([]string{"old", "api"}, "old", "")
+	if !slices.Equal(got, []string{"api"}) {
+		t.Fatalf("expected tag removal, got %v", got)
+	}
+}
+
+func newTagMonitorServer(t *testing.T, seen *[]string) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		mu.Lock()
+		*seen = append(*seen, strings.TrimSpace(req.Method+" "+req.URL.RequestURI()+" "+string(body)))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method + " " + req.URL.RequestURI() {
+		case "GET /monitors?tags=old":
+			_, _ = w.Write([]byte(`{"data":[{"id":1,"tags":[{"name":"old"},{"name":"web"}]},{"id":2,"tags":[{"name":"older"}]}]}`))
+		case "GET /monitors?tags=prod":
+			_, _ = w.Write([]byte(`{"data":[{"id":1,"tags":[{"name":"prod"}]},{"id":2,"tags":[{"name":"prod"},{"name":"web"}]}]}`))
+		case "GET /monitors/1":
+			_, _ = w.Write([]byte(`{"id":1,"friendlyName":"one","type":"HTTP","interval":300,"url":"https://one.example","followRedirections":true,"tags":[{"name":"old"},{"name":"web"}]}`))
+		case "GET /monitors/2":
+			// db was added after the listing above.
+			_, _ = w.Write([]byte(`{"id":2,"friendlyName":"two","type":"KEYWORD","interval":60,"url":"https://two.example","tags":[{"name":"prod"},{"name":"web"},{"name":"db"}]}`))
+		case "GET /monitors/3":
+			_, _ = w.Write([]byte(`{"id":3,"friendlyName":"three","type":"HEARTBEAT","interval":300,"url":"https://heartbeat.example","sslExpirationReminder":true,"tags":[{"name":"api"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":0}`))
+		}
+	}))
+}
+
+func TestRenameMonitorTags_RewritesAttachedMonitors(t *testing.T) {
+	t.Parallel()
+
+	var seen []string
+	server := newTagMonitorServer(t, &seen)
+	defer server.Close()
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(server.URL)
+
+	if err := renameMonitorTags(context.Background(), apiClient, "old", "new"); err != nil {
+		t.Fatalf("renameMonitorTags returned error: %v", err)
+	}
+
+	want := []string{
+		"GET /monitors?tags=old",
+		"GET /monitors/1",
+		`PATCH /monitors/1 {"friendlyName":"one","url":"https://one.example","type":"HTTP","interval":300,"tagNames":["new","web"],"sslExpirationReminder":false,"domainExpirationReminder":false,"followRedirections":true}`,
+	}
+	if strings.Join(seen, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected requests:\n%s", strings.Join(seen, "\n"))
+	}
+}
+
+func TestReconcileTagMonitors_AddsAndRemoves(t *testing.T) {
+	t.Parallel()
+
+	var seen []string
+	server := newTagMonitorServer(t, &seen)
+	defer server.Close()
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(server.URL)
+
+	if err := reconcileTagMonitors(context.Background(), apiClient, "prod", []int64{1, 3}); err != nil {
+		t.Fatalf("reconcileTagMonitors returned error: %v", err)
+	}
+
+	// Every tag change is a full update built from a fresh read of the
+	// monitor, so it keeps the monitor's settings and tags added since the
+	// listing.
+	want := []string{
+		"GET /monitors?tags=prod",
+		"GET /monitors/3",
+		`PATCH /monitors/3 {"friendlyName":"three","type":"HEARTBEAT","interval":300,"tagNames":["api","prod"],"sslExpirationReminder":true,"domainExpirationReminder":false,"followRedirections":false}`,
+		"GET /monitors/2",
+		`PATCH /monitors/2 {"friendlyName":"two","url":"https://two.example","type":"KEYWORD","interval":60,"tagNames":["db","web"],"sslExpirationReminder":false,"domainExpirationReminder":false,"followRedirections":false}`,
+	}
+	if strings.Join(seen, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected requests:\n%s", strings.Join(seen, "\n"))
+	}
+}
@@ -0,0 +1,298 @@
+package unmanagedobjects
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+var (
+	_ datasource.DataSource              = &unmanagedObjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &unmanagedObjectsDataSource{}
+)
+
+// NewDataSource returns the unmanaged objects data source.
+func NewDataSource() datasource.DataSource {
+	return &unmanagedObjectsDataSource{}
+}
+
+type unmanagedObjectsDataSource struct {
+	client *client.Client
+	stamp  *providerclient.OwnershipStamp
+}
+
+type unmanagedObjectsDataSourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	Key                         types.String `tfsdk:"key"`
+	Value                       types.String `tfsdk:"value"`
+	ManagedPSPIDs               types.Set    `tfsdk:"managed_psp_ids"`
+	ManagedMaintenanceWindowIDs types.Set    `tfsdk:"managed_maintenance_window_ids"`
+	ManagedIntegrationIDs       types.Set    `tfsdk:"managed_integration_ids"`
+	Monitors                    types.List   `tfsdk:"monitors"`
+	PSPs                        types.List   `tfsdk:"psps"`
+	MaintenanceWindows          types.List   `tfsdk:"maintenance_windows"`
+	Integrations                types.List   `tfsdk:"integrations"`
+}
+
+// unmanagedObjectTF is one listed object. type and url are null for kinds
+// that do not have them.
+type unmanagedObjectTF struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+	URL  types.String `tfsdk:"url"`
+}
+
+func unmanagedObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+		"type": types.StringType,
+		"url":  types.StringType,
+	}}
+}
+
+// stampFilter decides which monitors carry the ownership stamp. An empty
+// value accepts any value of key.
+type stampFilter struct {
+	key   string
+	value string
+}
+
+func (f stampFilter) stamped(fields map[string]string) bool {
+	v, ok := fields[f.key]
+	return ok && (f.value == "" || v == f.value)
+}
+
+func (d *unmanagedObjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := providerclient.DataFromDataSourceConfigure(req, resp)
+	if data == nil {
+		return
+	}
+	d.client = data.Client
+	d.stamp = data.OwnershipStamp
+}
+
+func (d *unmanagedObjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unmanaged_objects"
+}
+
+func (d *unmanagedObjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	listed := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: description,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id":   schema.StringAttribute{Computed: true, MarkdownDescription: "The object ID."},
+					"name": schema.StringAttribute{Computed: true, MarkdownDescription: "The object name."},
+					"type": schema.StringAttribute{Computed: true, MarkdownDescription: "The monitor or integration type; null for other objects."},
+					"url":  schema.StringAttribute{Computed: true, MarkdownDescription: "The monitor URL; null for other objects."},
+				},
+			},
+		}
+	}
+	managedIDs := func(kind string) schema.SetAttribute {
+		return schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			MarkdownDescription: "IDs of " + kind + " managed by Terraform. The API has no custom fields for " + kind +
+				", so they cannot carry the stamp and every one not listed here is reported.",
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists monitors, status pages, maintenance windows and integrations in the account that are not " +
+			"managed by Terraform, for example ones created by hand in the dashboard. Monitors are recognized by the provider " +
+			"`ownership_stamp` custom field. Monitors that were already managed when the stamp was enabled only get it on " +
+			"their next update, and are listed until then. Status pages, maintenance windows and integrations have no " +
+			"custom fields, so they are only recognized by the `managed_*_ids` arguments: every one not listed there is reported.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Static data source ID, always `unmanaged`.",
+			},
+			"key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Custom field key that marks managed monitors. Defaults to the provider `ownership_stamp` key.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only letters, numbers, underscores, and hyphens"),
+				},
+			},
+			"value": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Custom field value that marks managed monitors. When omitted, any value of `key` counts, so " +
+					"monitors stamped by other workspaces are not reported.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"managed_psp_ids":                managedIDs("status pages"),
+			"managed_maintenance_window_ids": managedIDs("maintenance windows"),
+			"managed_integration_ids":        managedIDs("integrations"),
+			"monitors":                       listed("Monitors without the stamp."),
+			"psps":                           listed("Status pages not in `managed_psp_ids`."),
+			"maintenance_windows":            listed("Maintenance windows not in `managed_maintenance_window_ids`."),
+			"integrations":                   listed("Integrations not in `managed_integration_ids`."),
+		},
+	}
+}
+
+func (d *unmanagedObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data unmanagedObjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client == nil {
+		resp.Diagnostics.AddError("Missing API Client", "The provider was not configured with an API client.")
+		return
+	}
+
+	filter := stampFilter{key: data.Key.ValueString(), value: data.Value.ValueString()}
+	if filter.key == "" && d.stamp != nil {
+		filter.key = d.stamp.Key
+	}
+	if filter.key == "" {
+		resp.Diagnostics.AddError(
+			"Missing Ownership Stamp",
+			"Configure key on the data source, or the ownership_stamp block on the provider, to tell managed monitors apart.",
+		)
+		return
+	}
+
+	var managedPSPs, managedWindows, managedIntegrations []string
+	resp.Diagnostics.Append(data.ManagedPSPIDs.ElementsAs(ctx, &managedPSPs, true)...)
+	resp.Diagnostics.Append(data.ManagedMaintenanceWindowIDs.ElementsAs(ctx, &managedWindows, true)...)
+	resp.Diagnostics.Append(data.ManagedIntegrationIDs.ElementsAs(ctx, &managedIntegrations, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitors, err := d.client.GetMonitors(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read monitors", err.Error())
+		return
+	}
+	psps, err := d.client.ListAllPSPs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read status pages", err.Error())
+		return
+	}
+	windows, err := d.client.ListAllMaintenanceWindows(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read maintenance windows", err.Error())
+		return
+	}
+	integrations, err := d.client.ListAllIntegrations(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read integrations", err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.ID = types.StringValue("unmanaged")
+	data.Monitors, diags = objectList(ctx, unmanagedMonitors(monitors, filter))
+	resp.Diagnostics.Append(diags...)
+	data.PSPs, diags = objectList(ctx, unmanagedPSPs(psps, managedPSPs))
+	resp.Diagnostics.Append(diags...)
+	data.MaintenanceWindows, diags = objectList(ctx, unmanagedMaintenanceWindows(windows, managedWindows))
+	resp.Diagnostics.Append(diags...)
+	data.Integrations, diags = objectList(ctx, unmanagedIntegrations(integrations, managedIntegrations))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func unmanagedMonitors(monitors []client.Monitor, filter stampFilter) []unmanagedObjectTF {
+	out := make([]unmanagedObjectTF, 0)
+	for _, m := range monitors {
+		if filter.stamped(m.CustomFields) {
+			continue
+		}
+		out = append(out, unmanagedObjectTF{
+			ID:   types.StringValue(strconv.FormatInt(m.ID, 10)),
+			Name: types.StringValue(m.Name),
+			Type: types.StringValue(m.Type),
+			URL:  optionalString(m.URL),
+		})
+	}
+	return out
+}
+
+func unmanagedPSPs(psps []client.PSP, managed []string) []unmanagedObjectTF {
+	out := make([]unmanagedObjectTF, 0)
+	for _, p := range psps {
+		id := strconv.FormatInt(p.ID, 10)
+		if slices.Contains(managed, id) {
+			continue
+		}
+		out = append(out, unmanagedObjectTF{
+			ID:   types.StringValue(id),
+			Name: types.StringValue(p.Name),
+			Type: types.StringNull(),
+			URL:  types.StringNull(),
+		})
+	}
+	return out
+}
+
+func unmanagedMaintenanceWindows(windows []client.MaintenanceWindow, managed []string) []unmanagedObjectTF {
+	out := make([]unmanagedObjectTF, 0)
+	for _, w := range windows {
+		id := strconv.FormatInt(w.ID, 10)
+		if slices.Contains(managed, id) {
+			continue
+		}
+		out = append(out, unmanagedObjectTF{
+			ID:   types.StringValue(id),
+			Name: types.StringValue(w.Name),
+			Type: types.StringNull(),
+			URL:  types.StringNull(),
+		})
+	}
+	return out
+}
+
+func unmanagedIntegrations(integrations []client.Integration, managed []string) []unmanagedObjectTF {
+	out := make([]unmanagedObjectTF, 0)
+	for _, i := range integrations {
+		id := strconv.FormatInt(i.ID, 10)
+		if slices.Contains(managed, id) {
+			continue
+		}
+		out = append(out, unmanagedObjectTF{
+			ID:   types.StringValue(id),
+			Name: types.StringValue(i.Name),
+			Type: types.StringValue(i.Type),
+			URL:  types.StringNull(),
+		})
+	}
+	return out
+}
+
+func objectList(ctx context.Context, objects []unmanagedObjectTF) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, unmanagedObjectType(), objects)
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,138 @@
+package unmanagedobjects
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider/providerclient"
+)
+
+func TestUnmanagedMonitors(t *testing.T) {
+	t.Parallel()
+
+	monitors := []client.Monitor{
+		{ID: 1, Name: "stamped", CustomFields: map[string]string{"managed_by": "terraform:prod"}},
+		{ID: 2, Name: "other workspace", CustomFields: map[string]string{"managed_by": "terraform:staging"}},
+		{ID: 3, Name: "manual", URL: "https://example.com", Type: "HTTP"},
+	}
+
+	got := unmanagedMonitors(monitors, stampFilter{key: "managed_by"})
+	if len(got) != 1 || got[0].ID.ValueString() != "3" || got[0].URL.ValueString() != "https://example.com" {
+		t.Fatalf("expected only the manual monitor, got %#v", got)
+	}
+
+	got = unmanagedMonitors(monitors, stampFilter{key: "managed_by", value: "terraform:prod"})
+	if len(got) != 2 || got[0].ID.ValueString() != "2" || got[1].ID.ValueString() != "3" {
+		t.Fatalf("expected the monitors without the prod stamp, got %#v", got)
+	}
+}
+
+func TestUnmanagedPSPsSkipsManagedIDs(t *testing.T) {
+	t.Parallel()
+
+	got := unmanagedPSPs([]client.PSP{{ID: 10, Name: "managed"}, {ID: 11, Name: "manual"}}, []string{"10"})
+	if len(got) != 1 || got[0].Name.ValueString() != "manual" || !got[0].Type.IsNull() {
+		t.Fatalf("expected only the manual status page, got %#v", got)
+	}
+}
+
+func TestUnmanagedMaintenanceWindowsSkipsManagedIDs(t *testing.T) {
+	t.Parallel()
+
+	got := unmanagedMaintenanceWindows([]client.MaintenanceWindow{{ID: 20, Name: "managed"}, {ID: 21, Name: "manual"}}, []string{"20"})
+	if len(got) != 1 || got[0].ID.ValueString() != "21" || got[0].Name.ValueString() != "manual" || !got[0].Type.IsNull() || !got[0].URL.IsNull() {
+		t.Fatalf("expected only the manual maintenance window, got %#v", got)
+	}
+
+	got = unmanagedMaintenanceWindows([]client.MaintenanceWindow{{ID: 20, Name: "managed"}}, nil)
+	if len(got) != 1 {
+		t.Fatalf("expected every window to be reported without managed IDs, got %#v", got)
+	}
+}
+
+func TestUnmanagedIntegrationsSkipsManagedIDs(t *testing.T) {
+	t.Parallel()
+
+	integrations := []client.Integration{
+		{ID: 30, Name: "managed", Type: "slack"},
+		{ID: 31, Name: "manual", Type: "webhook"},
+	}
+	got := unmanagedIntegrations(integrations, []string{"30"})
+	if len(got) != 1 || got[0].ID.ValueString() != "31" || got[0].Type.ValueString() != "webhook" || !got[0].URL.IsNull() {
+		t.Fatalf("expected only the manual integration with its type, got %#v", got)
+	}
+}
+
+func TestReadUsesProviderStampKey(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/monitors":
+			_, _ = w.Write([]byte(`{"data":[
+				{"id":1,"friendlyName":"stamped","type":"HTTP","url":"https://a.example.com","customFields":{"managed_by":"terraform:staging"}},
+				{"id":2,"friendlyName":"manual","type":"HTTP","url":"https://b.example.com"}
+			],"nextCursorId":null}`))
+		case "/psps", "/maintenance-windows":
+			_, _ = w.Write([]byte(`{"data":[],"nextCursorId":null}`))
+		case "/integrations":
+			_, _ = w.Write([]byte(`{"data":[],"nextLink":null}`))
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(srv.URL)
+
+	ctx := context.Background()
+	read := func(stamp *providerclient.OwnershipStamp) (unmanagedObjectsDataSourceModel, datasource.ReadResponse) {
+		d := NewDataSource()
+		d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{
+			ProviderData: &providerclient.Data{Client: apiClient, OwnershipStamp: stamp},
+		}, &datasource.ConfigureResponse{})
+
+		var schemaResp datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, typ := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+		raw := tftypes.NewValue(objectType, values)
+
+		resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw}}
+		d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}, &resp)
+		var model unmanagedObjectsDataSourceModel
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+		}
+		return model, resp
+	}
+
+	model, resp := read(&providerclient.OwnershipStamp{Key: "managed_by", Value: "terraform:prod"})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	var monitors []unmanagedObjectTF
+	resp.Diagnostics.Append(model.Monitors.ElementsAs(ctx, &monitors, false)...)
+	// The provider key is used without its value, so a monitor stamped by
+	// another workspace is not reported.
+	if resp.Diagnostics.HasError() || len(monitors) != 1 || monitors[0].ID.ValueString() != "2" {
+		t.Fatalf("expected only the manual monitor, got %#v, %v", monitors, resp.Diagnostics)
+	}
+
+	_, resp = read(nil)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Missing Ownership Stamp" {
+		t.Fatalf("expected a missing ownership stamp error, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,152 @@
+package tracing
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// fullProviderServer is the set of protocol interfaces the framework server
+// implements. The wrapper embeds it so every RPC it does not trace is passed
+// through unchanged; embedding less would hide the optional RPCs from
+// tf6server, which detects them by type assertion.
+type fullProviderServer interface {
+	tfprotov6.ProviderServerWithActions
+	tfprotov6.ProviderServerWithListResource
+	tfprotov6.ProviderServerWithStateStores
+}
+
+// WrapProviderServer returns a factory for provider servers that start a span
+// for every resource and data source operation. The spans come from the
+// global tracer provider, so they are dropped unless Start enabled tracing.
+// A server that does not implement every interface of fullProviderServer is
+// served unwrapped, with a warning.
+func WrapProviderServer(factory func() tfprotov6.ProviderServer) func() tfprotov6.ProviderServer {
+	return wrapProviderServer(factory, nil)
+}
+
+// wrapProviderServer is WrapProviderServer with tp in place of the global
+// tracer provider when it is not nil.
+func wrapProviderServer(factory func() tfprotov6.ProviderServer, tp trace.TracerProvider) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		inner := factory()
+		full, ok := inner.(fullProviderServer)
+		if !ok {
+			log.Printf("[WARN] provider server %T does not implement the expected protocol interfaces; serving it without tracing", inner)
+			return inner
+		}
+		if tp == nil {
+			tp = otel.GetTracerProvider()
+		}
+		return &tracingServer{fullProviderServer: full, tracer: tp.Tracer(InstrumentationName)}
+	}
+}
+
+type tracingServer struct {
+	fullProviderServer
+
+	tracer trace.Tracer
+}
+
+func (s *tracingServer) start(ctx context.Context, key attribute.Key, typeName, operation string) (context.Context, trace.Span) {
+	return s.tracer.Start(ctx, typeName+" "+operation, trace.WithAttributes(
+		key.String(typeName),
+		operationKey.String(operation),
+	))
+}
+
+// end records err and any error diagnostics on span and ends it.
+func end(span trace.Span, diags []*tfprotov6.Diagnostic, err error) {
+	defer span.End()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	for _, d := range diags {
+		if d != nil && d.Severity == tfprotov6.DiagnosticSeverityError {
+			span.SetStatus(codes.Error, d.Summary)
+			return
+		}
+	}
+}
+
+// applyOperation names the change ApplyResourceChange makes: a null prior
+// state is a create and a null planned state is a delete.
+func applyOperation(req *tfprotov6.ApplyResourceChangeRequest) string {
+	if isNull(req.PriorState) {
+		return "create"
+	}
+	if isNull(req.PlannedState) {
+		return "delete"
+	}
+	return "update"
+}
+
+func isNull(v *tfprotov6.DynamicValue) bool {
+	if v == nil {
+		return true
+	}
+	null, err := v.IsNull()
+	return err == nil && null
+}
+
+func (s *tracingServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	ctx, span := s.start(ctx, resourceTypeKey, req.TypeName, applyOperation(req))
+	resp, err := s.fullProviderServer.ApplyResourceChange(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracingServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx, span := s.start(ctx, resourceTypeKey, req.TypeName, "read")
+	resp, err := s.fullProviderServer.ReadResource(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracingServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx, span := s.start(ctx, resourceTypeKey, req.TypeName, "plan")
+	resp, err := s.fullProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracingServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx, span := s.start(ctx, resourceTypeKey, req.TypeName, "import")
+	resp, err := s.fullProviderServer.ImportResourceState(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
+
+func (s *tracingServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx, span := s.start(ctx, dataSourceTypeKey, req.TypeName, "read")
+	resp, err := s.fullProviderServer.ReadDataSource(ctx, req)
+	if resp != nil {
+		end(span, resp.Diagnostics, err)
+	} else {
+		end(span, nil, err)
+	}
+	return resp, err
+}
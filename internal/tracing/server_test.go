@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// fakeServer implements the provider protocol with only the RPCs a test
+// overrides; the others panic.
+type fakeServer struct {
+	fullProviderServer
+
+	apply func(context.Context, *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error)
+}
+
+func (s *fakeServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	return s.apply(ctx, req)
+}
+
+func (s *fakeServer) ReadDataSource(context.Context, *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return &tfprotov6.ReadDataSourceResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{{Severity: tfprotov6.DiagnosticSeverityError, Summary: "boom"}},
+	}, nil
+}
+
+func TestWrapProviderServer(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	fake := &fakeServer{apply: func(ctx context.Context, _ *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+		return &tfprotov6.ApplyResourceChangeResponse{}, nil
+	}}
+	server := wrapProviderServer(func() tfprotov6.ProviderServer { return fake }, tp)()
+
+	state := &tfprotov6.DynamicValue{JSON: []byte(`{"id":"1"}`)}
+	null := &tfprotov6.DynamicValue{MsgPack: []byte{0xc0}}
+	ctx := context.Background()
+	for _, req := range []*tfprotov6.ApplyResourceChangeRequest{
+		{TypeName: "uptimerobot_monitor", PriorState: null, PlannedState: state},
+		{TypeName: "uptimerobot_monitor", PriorState: state, PlannedState: state},
+		{TypeName: "uptimerobot_monitor", PriorState: state, PlannedState: null},
+	} {
+		if _, err := server.ApplyResourceChange(ctx, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{TypeName: "uptimerobot_account"}); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := recorder.Ended()
+	want := []string{
+		"uptimerobot_monitor create",
+		"uptimerobot_monitor update",
+		"uptimerobot_monitor delete",
+		"uptimerobot_account read",
+	}
+	if len(spans) != len(want) {
+		t.Fatalf("expected %d spans, got %d", len(want), len(spans))
+	}
+	for i, name := range want {
+		if spans[i].Name() != name {
+			t.Fatalf("span %d: expected %q, got %q", i, name, spans[i].Name())
+		}
+	}
+	if spans[0].Status().Code == codes.Error {
+		t.Fatalf("expected the create span to succeed, got %v", spans[0].Status())
+	}
+	if status := spans[3].Status(); status.Code != codes.Error || status.Description != "boom" {
+		t.Fatalf("expected the error diagnostic on the data source span, got %v", status)
+	}
+}
+
+func TestWrapProviderServerSkipsPartialServer(t *testing.T) {
+	t.Parallel()
+
+	var inner struct{ tfprotov6.ProviderServer }
+	server := wrapProviderServer(func() tfprotov6.ProviderServer { return inner }, nil)()
+	if _, ok := server.(*tracingServer); ok {
+		t.Fatal("expected a server without the optional protocol interfaces to be served unwrapped")
+	}
+}
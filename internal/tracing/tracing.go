@@ -0,0 +1,90 @@
+// Package tracing sets up optional OpenTelemetry tracing of the provider.
+//
+// Tracing is off unless an OTLP endpoint is configured with the standard
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT
+// variables; the exporter reads the other OTEL_EXPORTER_OTLP_* variables
+// itself. Each resource and data source operation becomes a span, and the
+// API client adds a child span per HTTP attempt.
+package tracing
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+// InstrumentationName names the tracer of the provider and its API client.
+const InstrumentationName = "github.com/uptimerobot/terraform-provider-uptimerobot"
+
+// Enabled reports whether the environment configures an OTLP endpoint for
+// traces.
+func Enabled() bool {
+	if disabled, _ := strconv.ParseBool(os.Getenv("OTEL_SDK_DISABLED")); disabled {
+		return false
+	}
+	return strings.TrimSpace(os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")) != "" ||
+		strings.TrimSpace(os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")) != ""
+}
+
+// Start installs the global tracer provider when Enabled and returns a
+// function that flushes and stops it. When tracing is not enabled nothing is
+// installed and the returned function does nothing.
+func Start(ctx context.Context, version string) (func(context.Context) error, error) {
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	if otlpProtocol() == "grpc" {
+		exporter, err = otlptracegrpc.New(ctx)
+	} else {
+		exporter, err = otlptracehttp.New(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName("terraform-provider-uptimerobot"),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// otlpProtocol returns the configured OTLP protocol for traces. The
+// specification default is http/protobuf.
+func otlpProtocol() string {
+	for _, key := range []string{"OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL"} {
+		if v := strings.TrimSpace(os.Getenv(key)); v != "" {
+			return strings.ToLower(v)
+		}
+	}
+	return "http/protobuf"
+}
+
+// Attribute keys set on operation spans.
+var (
+	resourceTypeKey   = attribute.Key("terraform.resource.type")
+	dataSourceTypeKey = attribute.Key("terraform.data_source.type")
+	operationKey      = attribute.Key("terraform.operation")
+)
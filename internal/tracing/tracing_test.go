@@ -0,0 +1,129 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/client"
+)
+
+// collector is an in-process OTLP/HTTP trace receiver.
+type collector struct {
+	mu    sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/v1/traces" {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req collectortrace.ExportTraceServiceRequest
+	if err := proto.Unmarshal(body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	c.mu.Lock()
+	for _, rs := range req.GetResourceSpans() {
+		for _, ss := range rs.GetScopeSpans() {
+			c.spans = append(c.spans, ss.GetSpans()...)
+		}
+	}
+	c.mu.Unlock()
+
+	out, _ := proto.Marshal(&collectortrace.ExportTraceServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(out)
+}
+
+func TestStartDisabledByDefault(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+	if Enabled() {
+		t.Fatal("expected tracing to be disabled without an endpoint")
+	}
+	shutdown, err := Start(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4318")
+	t.Setenv("OTEL_SDK_DISABLED", "true")
+	if Enabled() {
+		t.Fatal("expected OTEL_SDK_DISABLED to disable tracing")
+	}
+}
+
+func TestStartExportsOperationAndRequestSpans(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer api.Close()
+
+	received := &collector{}
+	otlp := httptest.NewServer(received)
+	defer otlp.Close()
+
+	prev := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", otlp.URL)
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "http/protobuf")
+
+	shutdown, err := Start(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiClient := client.NewClient("test-key")
+	apiClient.SetBaseURL(api.URL)
+	fake := &fakeServer{apply: func(ctx context.Context, _ *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+		if _, err := apiClient.GetMonitor(ctx, 1); err != nil {
+			return nil, err
+		}
+		return &tfprotov6.ApplyResourceChangeResponse{}, nil
+	}}
+	server := WrapProviderServer(func() tfprotov6.ProviderServer { return fake })()
+	if _, err := server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "uptimerobot_monitor",
+		PlannedState: &tfprotov6.DynamicValue{JSON: []byte(`{"id":"1"}`)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	received.mu.Lock()
+	defer received.mu.Unlock()
+	byName := map[string]*tracepb.Span{}
+	for _, s := range received.spans {
+		byName[s.GetName()] = s
+	}
+	operation, request := byName["uptimerobot_monitor create"], byName["uptimerobot GET"]
+	if operation == nil || request == nil {
+		t.Fatalf("expected the operation and request spans, got %d spans", len(received.spans))
+	}
+	if string(request.GetParentSpanId()) != string(operation.GetSpanId()) {
+		t.Fatal("expected the request span to be a child of the operation span")
+	}
+}
@@ -4,10 +4,16 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
+	"time"
 
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/export"
 	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/provider"
+	"github.com/uptimerobot/terraform-provider-uptimerobot/internal/tracing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -30,19 +36,43 @@ var (
 )
 
 func main() {
+	// "export" writes configuration for an existing account instead of serving the plugin.
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		os.Exit(export.Run(context.Background(), os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/uptimerobot/uptimerobot",
-		Debug:   debug,
+	// Tracing stays off unless OTEL_EXPORTER_OTLP_ENDPOINT or
+	// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set.
+	shutdownTracing, err := tracing.Start(context.Background(), version)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var opts []tf6server.ServeOpt
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	err = tf6server.Serve("registry.terraform.io/uptimerobot/uptimerobot", providerServer(), opts...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if shutdownErr := shutdownTracing(ctx); shutdownErr != nil {
+		log.Printf("flushing traces: %s", shutdownErr)
+	}
 
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 }
+
+// providerServer returns the factory of the served provider: the framework
+// server wrapped with tracing.
+func providerServer() func() tfprotov6.ProviderServer {
+	return tracing.WrapProviderServer(providerserver.NewProtocol6(provider.New(version)()))
+}